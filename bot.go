@@ -1,11 +1,14 @@
 package telego
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/valyala/fasthttp"
 
@@ -42,12 +45,17 @@ type Bot struct {
 	token       string
 	apiURL      string
 	log         Logger
+	logStruct   StructuredLogger
 	api         ta.Caller
 	constructor ta.RequestConstructor
 
 	useTestServerPath     bool
-	healthCheckRequested  bool
+	localMode             bool
+	checkUploadSize       bool
+	healthCheckContext    context.Context
 	reportWarningAsErrors bool
+	chatMigrated          func(oldChatID, newChatID int64)
+	interceptors          []Interceptor
 
 	longPollingContext *longPollingContext
 	webhookContext     *webhookContext
@@ -69,10 +77,13 @@ func NewBot(token string, options ...BotOption) (*Bot, error) {
 	}
 
 	b := &Bot{
-		token:       token,
-		apiURL:      defaultBotAPIServer,
-		log:         newDefaultLogger(token),
-		api:         ta.FastHTTPCaller{Client: &fasthttp.Client{}},
+		token:  token,
+		apiURL: defaultBotAPIServer,
+		log:    newDefaultLogger(token),
+		api: ta.FastHTTPCaller{
+			Client:         &fasthttp.Client{},
+			DownloadClient: &fasthttp.Client{StreamResponseBody: true},
+		},
 		constructor: ta.DefaultConstructor{},
 	}
 
@@ -82,8 +93,8 @@ func NewBot(token string, options ...BotOption) (*Bot, error) {
 		}
 	}
 
-	if b.healthCheckRequested {
-		if _, err := b.GetMe(); err != nil {
+	if b.healthCheckContext != nil {
+		if _, err := b.GetMe(b.healthCheckContext); err != nil {
 			return nil, fmt.Errorf("telego: health check: %w", err)
 		}
 	}
@@ -101,22 +112,70 @@ func (b *Bot) Logger() Logger {
 	return b.log
 }
 
+// StructuredLogger returns bot structured logger, if bot uses [Logger] it's adapted to [StructuredLogger]
+func (b *Bot) StructuredLogger() StructuredLogger {
+	if b.logStruct != nil {
+		return b.logStruct
+	}
+	if log, ok := b.log.(StructuredLogger); ok {
+		return log
+	}
+	return loggerAdapter{log: b.log}
+}
+
 // FileDownloadURL returns URL used to download file by its file path retrieved from GetFile method
+//
+// Note: In local mode (see [WithLocalAPIServer]) absolute file paths are returned as file URIs (file:///path/to/file),
+// since local Bot API server returns paths of files on its disk
 func (b *Bot) FileDownloadURL(filepath string) string {
+	if b.localMode && isAbsFilePath(filepath) {
+		return localFileURI(filepath)
+	}
+
 	if b.useTestServerPath {
 		return b.apiURL + "/file/bot" + b.token + "/test/" + filepath
 	}
 	return b.apiURL + "/file/bot" + b.token + "/" + filepath
 }
 
-// performRequest executes and parses response of method
-func (b *Bot) performRequest(methodName string, parameters any, vs ...any) error {
-	resp, err := b.constructAndCallRequest(methodName, parameters)
+// performRequest executes and parses response of method, call is passed through interceptors if any
+func (b *Bot) performRequest(ctx context.Context, methodName string, parameters any, vs ...any) error {
+	call := &APICall{
+		Method:      methodName,
+		Parameters:  parameters,
+		results:     vs,
+		resultIndex: -1,
+	}
+
+	if len(b.interceptors) == 0 {
+		return b.executeCall(ctx, call)
+	}
+	return b.interceptedHandler()(ctx, call)
+}
+
+// executeCall executes and parses response of API call
+func (b *Bot) executeCall(ctx context.Context, call *APICall) error {
+	methodName, parameters, vs := call.Method, call.Parameters, call.results
+
+	start := time.Now()
+	resp, err := b.constructAndCallRequest(ctx, methodName, parameters)
+	call.Latency = time.Since(start)
 	if err != nil {
-		b.log.Errorf("Execution error %s: %s", methodName, err)
+		b.logAttrs(ctx, slog.LevelError, "Execution error",
+			slog.String(LogKeyMethod, methodName), slog.Any(LogKeyError, err))
 		return fmt.Errorf("internal execution: %w", err)
 	}
-	b.log.Debugf("API response %s: %s", methodName, resp.String())
+	b.logResponse(ctx, methodName, resp, call.Latency)
+
+	if !resp.Ok && b.chatMigrated != nil {
+		resp, err = b.retryMigratedChat(ctx, methodName, parameters, resp)
+		call.Latency = time.Since(start)
+		if err != nil {
+			b.logAttrs(ctx, slog.LevelError, "Execution error",
+				slog.String(LogKeyMethod, methodName), slog.Any(LogKeyError, err))
+			return fmt.Errorf("internal execution: %w", err)
+		}
+	}
 
 	if !resp.Ok {
 		return fmt.Errorf("api: %w", resp.Error)
@@ -127,6 +186,7 @@ func (b *Bot) performRequest(methodName string, parameters any, vs ...any) error
 		for i := range vs {
 			unmarshalErr = json.Unmarshal(resp.Result, &vs[i])
 			if unmarshalErr == nil {
+				call.resultIndex = i
 				break
 			}
 		}
@@ -136,21 +196,42 @@ func (b *Bot) performRequest(methodName string, parameters any, vs ...any) error
 		}
 	}
 
-	if b.reportWarningAsErrors && resp.Error != nil {
-		return resp.Error
+	if resp.Error != nil {
+		if b.reportWarningAsErrors {
+			return resp.Error
+		}
+		b.logAttrs(ctx, slog.LevelWarn, "API warning",
+			slog.String(LogKeyMethod, methodName), slog.Any(LogKeyError, resp.Error))
 	}
 
 	return nil
 }
 
+// logResponse logs API response on debug level
+func (b *Bot) logResponse(ctx context.Context, methodName string, resp *ta.Response, duration time.Duration) {
+	if !b.logEnabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	b.logAttrs(ctx, slog.LevelDebug, "API response",
+		slog.String(LogKeyMethod, methodName),
+		slog.String(LogKeyResponse, resp.String()),
+		slog.Duration(LogKeyDuration, duration),
+	)
+}
+
 // constructAndCallRequest creates and executes request with parsing of parameters
-func (b *Bot) constructAndCallRequest(methodName string, parameters any) (*ta.Response, error) {
+func (b *Bot) constructAndCallRequest(ctx context.Context, methodName string, parameters any) (*ta.Response, error) {
 	filesParams, hasFiles := filesParameters(parameters)
 	var data *ta.RequestData
 
 	debug := &strings.Builder{}
 
 	if hasFiles {
+		if err := b.checkFilesSize(filesParams); err != nil {
+			return nil, err
+		}
+
 		parsedParameters, err := parseParameters(parameters)
 		if err != nil {
 			return nil, fmt.Errorf("parsing parameters: %w", err)
@@ -169,8 +250,11 @@ func (b *Bot) constructAndCallRequest(methodName string, parameters any) (*ta.Re
 			return nil, fmt.Errorf("json request: %w", err)
 		}
 
-		_, _ = debug.WriteString(data.Buffer.String())
+		if data.Buffer != nil {
+			_, _ = debug.WriteString(data.Buffer.String())
+		}
 	}
+	defer data.CloseBody()
 
 	var url string
 	if b.useTestServerPath {
@@ -179,10 +263,15 @@ func (b *Bot) constructAndCallRequest(methodName string, parameters any) (*ta.Re
 		url = b.apiURL + botPathPrefix + b.token + "/" + methodName
 	}
 
-	debugData := strings.TrimSuffix(debug.String(), "\n")
-	b.log.Debugf("API call to: %q, with data: %s", url, debugData)
+	if b.logEnabled(ctx, slog.LevelDebug) {
+		b.logAttrs(ctx, slog.LevelDebug, "API call",
+			slog.String(LogKeyMethod, methodName),
+			slog.String(LogKeyURL, url),
+			slog.String(LogKeyData, strings.TrimSuffix(debug.String(), "\n")),
+		)
+	}
 
-	resp, err := b.api.Call(url, data)
+	resp, err := b.api.Call(ctx, url, data)
 	if err != nil {
 		return nil, fmt.Errorf("request call: %w", err)
 	}
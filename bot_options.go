@@ -1,7 +1,9 @@
 package telego
 
 import (
+	"context"
 	"errors"
+	"log/slog"
 	"net/http"
 	"os"
 	"strings"
@@ -19,14 +21,52 @@ func WithAPICaller(caller ta.Caller) BotOption {
 	}
 }
 
-// WithFastHTTPClient sets fasthttp client to use
+// WithFastHTTPClient sets fasthttp client to use, for downloads the same client is used if it has StreamResponseBody
+// enabled, otherwise its copy with StreamResponseBody enabled is used to not read whole downloaded files into memory
 func WithFastHTTPClient(client *fasthttp.Client) BotOption {
 	return func(bot *Bot) error {
-		bot.api = ta.FastHTTPCaller{Client: client}
+		if client == nil {
+			return errors.New("fasthttp client is nil")
+		}
+
+		bot.api = ta.FastHTTPCaller{Client: client, DownloadClient: streamingFastHTTPClient(client)}
 		return nil
 	}
 }
 
+// streamingFastHTTPClient returns client with StreamResponseBody enabled and the same settings as the client
+func streamingFastHTTPClient(client *fasthttp.Client) *fasthttp.Client {
+	if client.StreamResponseBody {
+		return client
+	}
+
+	return &fasthttp.Client{
+		DialTimeout:                   client.DialTimeout,
+		Dial:                          client.Dial,
+		TLSConfig:                     client.TLSConfig,
+		RetryIf:                       client.RetryIf,
+		RetryIfErr:                    client.RetryIfErr,
+		ConfigureClient:               client.ConfigureClient,
+		Name:                          client.Name,
+		MaxConnsPerHost:               client.MaxConnsPerHost,
+		MaxIdleConnDuration:           client.MaxIdleConnDuration,
+		MaxConnDuration:               client.MaxConnDuration,
+		MaxIdemponentCallAttempts:     client.MaxIdemponentCallAttempts,
+		ReadBufferSize:                client.ReadBufferSize,
+		WriteBufferSize:               client.WriteBufferSize,
+		ReadTimeout:                   client.ReadTimeout,
+		WriteTimeout:                  client.WriteTimeout,
+		MaxResponseBodySize:           client.MaxResponseBodySize,
+		MaxConnWaitTimeout:            client.MaxConnWaitTimeout,
+		ConnPoolStrategy:              client.ConnPoolStrategy,
+		NoDefaultUserAgentHeader:      client.NoDefaultUserAgentHeader,
+		DialDualStack:                 client.DialDualStack,
+		DisableHeaderNamesNormalizing: client.DisableHeaderNamesNormalizing,
+		DisablePathNormalizing:        client.DisablePathNormalizing,
+		StreamResponseBody:            true,
+	}
+}
+
 // WithHTTPClient sets http client to use
 func WithHTTPClient(client *http.Client) BotOption {
 	return func(bot *Bot) error {
@@ -55,6 +95,7 @@ func WithDefaultLogger(debugMode, printErrors bool) BotOption {
 			Replacer:    defaultReplacer(bot.Token()),
 		}
 		bot.log = log
+		bot.logStruct = nil
 		return nil
 	}
 }
@@ -71,6 +112,7 @@ func WithExtendedDefaultLogger(debugMode, printErrors bool, replacer *strings.Re
 			Replacer:    replacer,
 		}
 		bot.log = log
+		bot.logStruct = nil
 		return nil
 	}
 }
@@ -92,10 +134,41 @@ func WithDiscardLogger() BotOption {
 func WithLogger(log Logger) BotOption {
 	return func(bot *Bot) error {
 		bot.log = log
+		bot.logStruct = nil
+		return nil
+	}
+}
+
+// WithStructuredLogger sets structured logger to use, bot token is replaced with [DefaultLoggerTokenReplacement] in
+// messages and attributes. Redefines existing loggers.
+// Note: Logger is also adapted to [Logger] and used where structured logging isn't supported (like webhook servers).
+func WithStructuredLogger(log StructuredLogger) BotOption {
+	return func(bot *Bot) error {
+		if log == nil {
+			return errors.New("structured logger is nil")
+		}
+
+		bot.logStruct = redactingLogger{
+			log:      log,
+			replacer: defaultReplacer(bot.Token()),
+		}
+		bot.log = structuredLoggerAdapter{log: bot.logStruct}
 		return nil
 	}
 }
 
+// WithSlogLogger sets [slog.Logger] to use, bot token is replaced with [DefaultLoggerTokenReplacement] in messages
+// and attributes. Redefines existing loggers.
+func WithSlogLogger(log *slog.Logger) BotOption {
+	return func(bot *Bot) error {
+		if log == nil {
+			return errors.New("slog logger is nil")
+		}
+
+		return WithStructuredLogger(NewSlogLogger(log))(bot)
+	}
+}
+
 // WithAPIServer sets bot API server URL to use
 func WithAPIServer(apiURL string) BotOption {
 	return func(bot *Bot) error {
@@ -108,6 +181,34 @@ func WithAPIServer(apiURL string) BotOption {
 	}
 }
 
+// WithUploadSizeCheck enables check of uploaded files size, calls with files larger than [Bot.MaxUploadSize] fail with
+// [ErrFileTooLarge] before sending a request. Only files with known size (e.g. [os.File] or [bytes.Reader]) are
+// checked, and nothing is checked if upload limit is unknown.
+// By default, upload size is not checked and limits are enforced by Bot API server.
+func WithUploadSizeCheck() BotOption {
+	return func(bot *Bot) error {
+		bot.checkUploadSize = true
+		return nil
+	}
+}
+
+// WithLocalAPIServer sets local Bot API server (telegram-bot-api started with --local flag) to use and enables
+// local mode. In local mode files can be uploaded by their path on server's disk (see [InputFile.LocalPath]),
+// absolute file paths returned by GetFile are converted to file URIs by [Bot.FileDownloadURL], and file size limits
+// are raised (see [Bot.MaxUploadSize], [Bot.MaxDownloadSize] and [WithUploadSizeCheck]).
+// More details: https://github.com/tdlib/telegram-bot-api#usage
+func WithLocalAPIServer(apiURL string) BotOption {
+	return func(bot *Bot) error {
+		if apiURL == "" {
+			return errors.New("empty local bot api server url")
+		}
+
+		bot.apiURL = apiURL
+		bot.localMode = true
+		return nil
+	}
+}
+
 // WithTestServerPath use the test server API path instead of regular API path
 //
 // Regular API: https://<api-server>/bot<token>/<method-name>
@@ -119,10 +220,14 @@ func WithTestServerPath() BotOption {
 	}
 }
 
-// WithHealthCheck enables health check using [Bot.GetMe] method on start
-func WithHealthCheck() BotOption {
+// WithHealthCheck enables health check using [Bot.GetMe] method on start, provided context is used for the call
+func WithHealthCheck(ctx context.Context) BotOption {
 	return func(bot *Bot) error {
-		bot.healthCheckRequested = true
+		if ctx == nil {
+			return errors.New("health check context is nil")
+		}
+
+		bot.healthCheckContext = ctx
 		return nil
 	}
 }
@@ -135,3 +240,38 @@ func WithWarnings() BotOption {
 		return nil
 	}
 }
+
+// WithChatMigration enables automatic handling of group to supergroup migrations, calls that failed because the group
+// was migrated are retried once with the new supergroup ID, and handler is called with old and new chat IDs. Handler
+// is also called when updates with [Message.MigrateToChatID] or [Message.MigrateFromChatID] service messages are
+// received via [Bot.UpdatesViaLongPolling] or [Bot.UpdatesViaWebhook].
+// Note: Handler may be called multiple times for the same migration, so it should be idempotent.
+// Note: Calls that upload files from readers (e.g. [Bot.SendPhoto], [Bot.SendDocument] or [Bot.SendMediaGroup] with
+// [InputFile.File] set) are not retried, since readers were already consumed, handler is still called, but the
+// migration error ([telegoapi.ErrChatMigrated]) is returned, so such calls should be repeated by the caller with the
+// new chat ID. Files sent by ID or URL are retried as any other call.
+func WithChatMigration(handler func(oldChatID, newChatID int64)) BotOption {
+	return func(bot *Bot) error {
+		if handler == nil {
+			return errors.New("chat migration handler is nil")
+		}
+
+		bot.chatMigrated = handler
+		return nil
+	}
+}
+
+// WithInterceptors adds interceptors that are called around every API call in order of addition (the first one is
+// the outermost)
+func WithInterceptors(interceptors ...Interceptor) BotOption {
+	return func(bot *Bot) error {
+		for _, interceptor := range interceptors {
+			if interceptor == nil {
+				return errors.New("interceptor is nil")
+			}
+		}
+
+		bot.interceptors = append(bot.interceptors, interceptors...)
+		return nil
+	}
+}
@@ -1,9 +1,13 @@
 package telego
 
 import (
+	"context"
+	"io"
+	"log/slog"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,7 +18,7 @@ import (
 
 type testCallerType struct{}
 
-func (c testCallerType) Call(_ string, _ *ta.RequestData) (*ta.Response, error) {
+func (c testCallerType) Call(_ context.Context, _ string, _ *ta.RequestData) (*ta.Response, error) {
 	panic("implement me")
 }
 
@@ -29,10 +33,27 @@ func TestWithAPICaller(t *testing.T) {
 
 func TestWithFastHTTPClient(t *testing.T) {
 	bot := &Bot{}
-	client := &fasthttp.Client{}
+	client := &fasthttp.Client{StreamResponseBody: true}
 
 	err := WithFastHTTPClient(client)(bot)
 	require.NoError(t, err)
+	assert.Equal(t, ta.FastHTTPCaller{Client: client, DownloadClient: client}, bot.api)
+
+	client = &fasthttp.Client{Name: "test", ReadTimeout: time.Second}
+	err = WithFastHTTPClient(client)(bot)
+	require.NoError(t, err)
+
+	caller, ok := bot.api.(ta.FastHTTPCaller)
+	require.True(t, ok)
+	assert.Same(t, client, caller.Client)
+	require.NotSame(t, client, caller.DownloadClient)
+	assert.True(t, caller.DownloadClient.StreamResponseBody)
+	assert.Equal(t, "test", caller.DownloadClient.Name)
+	assert.Equal(t, time.Second, caller.DownloadClient.ReadTimeout)
+	assert.False(t, client.StreamResponseBody)
+
+	err = WithFastHTTPClient(nil)(bot)
+	require.Error(t, err)
 }
 
 func TestWithHTTPClient(t *testing.T) {
@@ -153,6 +174,30 @@ func TestWithAPIServer(t *testing.T) {
 	})
 }
 
+func TestWithUploadSizeCheck(t *testing.T) {
+	bot := &Bot{}
+
+	err := WithUploadSizeCheck()(bot)
+	require.NoError(t, err)
+	assert.True(t, bot.checkUploadSize)
+}
+
+func TestWithLocalAPIServer(t *testing.T) {
+	bot := &Bot{}
+
+	t.Run("success", func(t *testing.T) {
+		err := WithLocalAPIServer("test")(bot)
+		require.NoError(t, err)
+		assert.Equal(t, "test", bot.apiURL)
+		assert.True(t, bot.localMode)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := WithLocalAPIServer("")(bot)
+		require.Error(t, err)
+	})
+}
+
 func TestWithDefaultDebugLogger(t *testing.T) {
 	bot := &Bot{}
 
@@ -179,10 +224,20 @@ func TestWithTestServerPath(t *testing.T) {
 func TestWithHealthCheck(t *testing.T) {
 	bot := &Bot{}
 
-	err := WithHealthCheck()(bot)
-	require.NoError(t, err)
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+
+		err := WithHealthCheck(ctx)(bot)
+		require.NoError(t, err)
 
-	assert.True(t, bot.healthCheckRequested)
+		assert.Equal(t, ctx, bot.healthCheckContext)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		//nolint:staticcheck
+		err := WithHealthCheck(nil)(bot)
+		require.Error(t, err)
+	})
 }
 
 func TestWithWarnings(t *testing.T) {
@@ -193,3 +248,85 @@ func TestWithWarnings(t *testing.T) {
 
 	assert.True(t, bot.reportWarningAsErrors)
 }
+
+func TestWithChatMigration(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		bot := &Bot{}
+
+		err := WithChatMigration(func(_, _ int64) {})(bot)
+		require.NoError(t, err)
+
+		assert.NotNil(t, bot.chatMigrated)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		bot := &Bot{}
+
+		err := WithChatMigration(nil)(bot)
+		require.Error(t, err)
+	})
+}
+
+func TestWithInterceptors(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		bot := &Bot{}
+
+		interceptor := func(ctx context.Context, call *APICall, next APICallHandler) error {
+			return next(ctx, call)
+		}
+
+		err := WithInterceptors(interceptor, interceptor)(bot)
+		require.NoError(t, err)
+		assert.Len(t, bot.interceptors, 2)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		bot := &Bot{}
+
+		err := WithInterceptors(nil)(bot)
+		require.Error(t, err)
+	})
+}
+
+func TestWithStructuredLogger(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		bot := &Bot{token: token}
+		structuredLog := &testStructuredLogger{}
+
+		err := WithStructuredLogger(structuredLog)(bot)
+		require.NoError(t, err)
+
+		assert.Equal(t, redactingLogger{log: structuredLog, replacer: defaultReplacer(token)}, bot.logStruct)
+		assert.Equal(t, structuredLoggerAdapter{log: bot.logStruct}, bot.log)
+
+		err = WithLogger(testLoggerType{})(bot)
+		require.NoError(t, err)
+		assert.Nil(t, bot.logStruct)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		bot := &Bot{}
+
+		err := WithStructuredLogger(nil)(bot)
+		require.Error(t, err)
+	})
+}
+
+func TestWithSlogLogger(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		bot := &Bot{token: token}
+		log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+		err := WithSlogLogger(log)(bot)
+		require.NoError(t, err)
+
+		assert.Equal(t, redactingLogger{log: NewSlogLogger(log), replacer: defaultReplacer(token)}, bot.logStruct)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		bot := &Bot{}
+
+		err := WithSlogLogger(nil)(bot)
+		require.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package telego
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -109,11 +110,11 @@ func TestNewBot(t *testing.T) {
 				Times(1)
 
 			caller.EXPECT().
-				Call(defaultBotAPIServer+botPathPrefix+token+"/getMe", expectedData).
+				Call(gomock.Any(), defaultBotAPIServer+botPathPrefix+token+"/getMe", expectedData).
 				Return(expectedResp, nil).
 				Times(1)
 
-			bot, err := NewBot(token, WithHealthCheck(), WithAPICaller(caller), WithRequestConstructor(constructor))
+			bot, err := NewBot(token, WithHealthCheck(context.Background()), WithAPICaller(caller), WithRequestConstructor(constructor))
 
 			require.NoError(t, err)
 			assert.NotNil(t, bot)
@@ -131,11 +132,11 @@ func TestNewBot(t *testing.T) {
 				Times(1)
 
 			caller.EXPECT().
-				Call(defaultBotAPIServer+botPathPrefix+token+"/getMe", expectedData).
+				Call(gomock.Any(), defaultBotAPIServer+botPathPrefix+token+"/getMe", expectedData).
 				Return(expectedResp, nil).
 				Times(1)
 
-			bot, err := NewBot(token, WithHealthCheck(), WithAPICaller(caller), WithRequestConstructor(constructor))
+			bot, err := NewBot(token, WithHealthCheck(context.Background()), WithAPICaller(caller), WithRequestConstructor(constructor))
 
 			require.Error(t, err)
 			assert.Nil(t, bot)
@@ -175,6 +176,16 @@ func TestBot_FileDownloadURL(t *testing.T) {
 		url := bot.FileDownloadURL(filepath)
 		assert.Equal(t, bot.apiURL+"/file"+botPathPrefix+bot.token+"/test/"+filepath, url)
 	})
+
+	t.Run("local", func(t *testing.T) {
+		bot, err := NewBot(token, WithLocalAPIServer("http://localhost:8081"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "file:///var/lib/telegram-bot-api/file.txt",
+			bot.FileDownloadURL("/var/lib/telegram-bot-api/file.txt"))
+		assert.Equal(t, "http://localhost:8081/file"+botPathPrefix+bot.token+"/file.txt",
+			bot.FileDownloadURL("file.txt"))
+	})
 }
 
 type testErrorMarshal struct {
@@ -420,11 +431,11 @@ func TestBot_constructAndCallRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(url, expectedData).
+			Call(gomock.Any(), url, expectedData).
 			Return(expectedResp, nil).
 			Times(1)
 
-		resp, err := m.Bot.constructAndCallRequest(methodName, params)
+		resp, err := m.Bot.constructAndCallRequest(context.Background(), methodName, params)
 		require.NoError(t, err)
 		assert.Equal(t, expectedResp, resp)
 	})
@@ -435,7 +446,7 @@ func TestBot_constructAndCallRequest(t *testing.T) {
 			Return(nil, errTest).
 			Times(1)
 
-		resp, err := m.Bot.constructAndCallRequest(methodName, params)
+		resp, err := m.Bot.constructAndCallRequest(context.Background(), methodName, params)
 		require.ErrorIs(t, err, errTest)
 		assert.Nil(t, resp)
 	})
@@ -460,11 +471,11 @@ func TestBot_constructAndCallRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(url, expectedDataFile).
+			Call(gomock.Any(), url, expectedDataFile).
 			Return(expectedResp, nil).
 			Times(1)
 
-		resp, err := m.Bot.constructAndCallRequest(methodName, paramsFile)
+		resp, err := m.Bot.constructAndCallRequest(context.Background(), methodName, paramsFile)
 		require.NoError(t, err)
 		assert.Equal(t, expectedResp, resp)
 	})
@@ -480,7 +491,7 @@ func TestBot_constructAndCallRequest(t *testing.T) {
 			Return(nil, errTest).
 			Times(1)
 
-		resp, err := m.Bot.constructAndCallRequest(methodName, paramsFile)
+		resp, err := m.Bot.constructAndCallRequest(context.Background(), methodName, paramsFile)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
@@ -488,7 +499,7 @@ func TestBot_constructAndCallRequest(t *testing.T) {
 	t.Run("error_multipart_params", func(t *testing.T) {
 		notStruct := notStructParamsWithFile("test")
 
-		resp, err := m.Bot.constructAndCallRequest(methodName, &notStruct)
+		resp, err := m.Bot.constructAndCallRequest(context.Background(), methodName, &notStruct)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
@@ -500,11 +511,11 @@ func TestBot_constructAndCallRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(url, expectedData).
+			Call(gomock.Any(), url, expectedData).
 			Return(nil, errTest).
 			Times(1)
 
-		resp, err := m.Bot.constructAndCallRequest(methodName, params)
+		resp, err := m.Bot.constructAndCallRequest(context.Background(), methodName, params)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
@@ -529,14 +540,14 @@ func TestBot_performRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(&ta.Response{
 				Ok:     true,
 				Result: bytes.NewBufferString("1").Bytes(),
 				Error:  nil,
 			}, nil)
 
-		err := m.Bot.performRequest(methodName, params, &result)
+		err := m.Bot.performRequest(context.Background(), methodName, params, &result)
 		require.NoError(t, err)
 		assert.Equal(t, 1, result)
 	})
@@ -551,14 +562,14 @@ func TestBot_performRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(&ta.Response{
 				Ok:     true,
 				Result: bytes.NewBufferString("true").Bytes(),
 				Error:  nil,
 			}, nil)
 
-		err := m.Bot.performRequest(methodName, params, &result1, &result2)
+		err := m.Bot.performRequest(context.Background(), methodName, params, &result1, &result2)
 		require.NoError(t, err)
 		assert.Equal(t, 0, result1)
 		assert.True(t, result2)
@@ -573,15 +584,39 @@ func TestBot_performRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(&ta.Response{
 				Ok:     false,
 				Result: nil,
 				Error:  &ta.Error{},
 			}, nil)
 
-		err := m.Bot.performRequest(methodName, params, &result)
+		err := m.Bot.performRequest(context.Background(), methodName, params, &result)
+		require.Error(t, err)
+	})
+
+	t.Run("error_api_kind", func(t *testing.T) {
+		var result int
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(&ta.RequestData{}, nil).
+			Times(1)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{
+				Ok: false,
+				Error: &ta.Error{
+					ErrorCode:   403,
+					Description: "Forbidden: bot was blocked by the user",
+				},
+			}, nil)
+
+		err := m.Bot.performRequest(context.Background(), methodName, params, &result)
 		require.Error(t, err)
+		assert.ErrorIs(t, err, ta.ErrForbidden)
+		assert.True(t, ta.IsBlocked(err))
 	})
 
 	t.Run("error_construct_and_call", func(t *testing.T) {
@@ -592,7 +627,7 @@ func TestBot_performRequest(t *testing.T) {
 			Return(nil, errTest).
 			Times(1)
 
-		err := m.Bot.performRequest(methodName, params, &result)
+		err := m.Bot.performRequest(context.Background(), methodName, params, &result)
 		require.Error(t, err)
 	})
 
@@ -603,7 +638,7 @@ func TestBot_performRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(&ta.Response{
 				Ok:     true,
 				Result: bytes.NewBufferString("1").Bytes(),
@@ -611,7 +646,7 @@ func TestBot_performRequest(t *testing.T) {
 			}, nil)
 
 		var stringResult string
-		err := m.Bot.performRequest(methodName, params, &stringResult)
+		err := m.Bot.performRequest(context.Background(), methodName, params, &stringResult)
 		require.Error(t, err)
 		assert.Equal(t, "", stringResult)
 	})
@@ -625,14 +660,14 @@ func TestBot_performRequest(t *testing.T) {
 			Times(1)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(&ta.Response{
 				Ok:     true,
 				Result: bytes.NewBufferString("1").Bytes(),
 				Error:  &ta.Error{ErrorCode: 1},
 			}, nil)
 
-		err := m.Bot.performRequest(methodName, params, &result)
+		err := m.Bot.performRequest(context.Background(), methodName, params, &result)
 		assert.Equal(t, &ta.Error{ErrorCode: 1}, err)
 		assert.Equal(t, 1, result)
 	})
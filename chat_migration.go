@@ -0,0 +1,107 @@
+package telego
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+// chatIDFieldName name of the parameters field that holds the chat ID
+const chatIDFieldName = "ChatID"
+
+// retryMigratedChat retries the call with the new chat ID if it failed because the group was migrated to a
+// supergroup, returns the original response if the call can't be retried
+func (b *Bot) retryMigratedChat(
+	ctx context.Context, methodName string, parameters any, resp *ta.Response,
+) (*ta.Response, error) {
+	if resp.Error == nil || resp.Parameters == nil || resp.Parameters.MigrateToChatID == 0 {
+		return resp, nil
+	}
+	newChatID := resp.Parameters.MigrateToChatID
+
+	oldChatID, ok := parametersChatID(parameters)
+	if !ok || oldChatID.ID == 0 || oldChatID.ID == newChatID {
+		return resp, nil
+	}
+
+	b.logAttrs(ctx, slog.LevelInfo, "Chat migrated",
+		slog.String(LogKeyMethod, methodName),
+		slog.Int64(LogKeyChatID, oldChatID.ID),
+		slog.Int64("migrate_to_chat_id", newChatID),
+	)
+	b.chatMigrated(oldChatID.ID, newChatID)
+
+	// Readers of uploaded files were already consumed by the first call, so the call can't be repeated
+	if _, hasFiles := filesParameters(parameters); hasFiles {
+		return resp, nil
+	}
+
+	migratedParameters, ok := withParametersChatID(parameters, ChatID{ID: newChatID})
+	if !ok {
+		return resp, nil
+	}
+
+	start := time.Now()
+	resp, err := b.constructAndCallRequest(ctx, methodName, migratedParameters)
+	if err != nil {
+		return nil, err
+	}
+	b.logResponse(ctx, methodName, resp, time.Since(start))
+
+	return resp, nil
+}
+
+// parametersChatID returns chat ID from parameters if present, parameters should be a pointer to struct
+func parametersChatID(parameters any) (ChatID, bool) {
+	field, ok := parametersChatIDField(reflect.ValueOf(parameters))
+	if !ok {
+		return ChatID{}, false
+	}
+	return field.Interface().(ChatID), true //nolint:forcetypeassert
+}
+
+// withParametersChatID returns a shallow copy of parameters with chat ID replaced, original parameters are not
+// modified
+func withParametersChatID(parameters any, chatID ChatID) (any, bool) {
+	value := reflect.ValueOf(parameters)
+	if _, ok := parametersChatIDField(value); !ok {
+		return nil, false
+	}
+
+	copyValue := reflect.New(value.Elem().Type())
+	copyValue.Elem().Set(value.Elem())
+	copyValue.Elem().FieldByName(chatIDFieldName).Set(reflect.ValueOf(chatID))
+
+	return copyValue.Interface(), true
+}
+
+// parametersChatIDField returns chat ID field of parameters if present
+func parametersChatIDField(value reflect.Value) (reflect.Value, bool) {
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := value.Elem().FieldByName(chatIDFieldName)
+	if !field.IsValid() || field.Type() != reflect.TypeOf(ChatID{}) {
+		return reflect.Value{}, false
+	}
+
+	return field, true
+}
+
+// handleChatMigrationUpdate calls chat migration handler if update contains migration service message
+func (b *Bot) handleChatMigrationUpdate(update Update) {
+	if b.chatMigrated == nil || update.Message == nil {
+		return
+	}
+
+	switch {
+	case update.Message.MigrateToChatID != 0:
+		b.chatMigrated(update.Message.Chat.ID, update.Message.MigrateToChatID)
+	case update.Message.MigrateFromChatID != 0:
+		b.chatMigrated(update.Message.MigrateFromChatID, update.Message.Chat.ID)
+	}
+}
@@ -0,0 +1,214 @@
+package telego
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+type chatMigration struct {
+	oldChatID int64
+	newChatID int64
+}
+
+func TestBot_retryMigratedChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := newMockedBot(ctrl)
+
+	var migrations []chatMigration
+	m.Bot.chatMigrated = func(oldChatID, newChatID int64) {
+		migrations = append(migrations, chatMigration{oldChatID: oldChatID, newChatID: newChatID})
+	}
+
+	migratedResp := &ta.Response{
+		Ok: false,
+		Error: &ta.Error{
+			ErrorCode:   400,
+			Description: "Bad Request: group chat was upgraded to a supergroup chat",
+			Parameters:  &ta.ResponseParameters{MigrateToChatID: -1002},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		migrations = nil
+		params := &SendMessageParams{ChatID: ChatID{ID: -1}, Text: "test"}
+
+		gomock.InOrder(
+			m.MockRequestConstructor.EXPECT().
+				JSONRequest(params).
+				Return(data, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(migratedResp, nil),
+			m.MockRequestConstructor.EXPECT().
+				JSONRequest(&SendMessageParams{ChatID: ChatID{ID: -1002}, Text: "test"}).
+				Return(data, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, &Message{MessageID: 1}), nil),
+		)
+
+		msg, err := m.Bot.SendMessage(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, 1, msg.MessageID)
+		assert.Equal(t, []chatMigration{{oldChatID: -1, newChatID: -1002}}, migrations)
+		assert.Equal(t, ChatID{ID: -1}, params.ChatID)
+	})
+
+	t.Run("error_no_chat_id", func(t *testing.T) {
+		migrations = nil
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(migratedResp, nil)
+
+		_, err := m.Bot.SendMessage(context.Background(), &SendMessageParams{
+			ChatID: ChatID{Username: "@test"},
+		})
+		require.ErrorIs(t, err, ta.ErrChatMigrated)
+		assert.Empty(t, migrations)
+	})
+
+	t.Run("error_with_files", func(t *testing.T) {
+		migrations = nil
+
+		m.MockRequestConstructor.EXPECT().
+			MultipartRequest(gomock.Any(), gomock.Any()).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(migratedResp, nil)
+
+		_, err := m.Bot.SendDocument(context.Background(), &SendDocumentParams{
+			ChatID:   ChatID{ID: -1},
+			Document: testInputFile,
+		})
+		require.ErrorIs(t, err, ta.ErrChatMigrated)
+		assert.Equal(t, []chatMigration{{oldChatID: -1, newChatID: -1002}}, migrations)
+	})
+
+	t.Run("error_with_media_group_files", func(t *testing.T) {
+		migrations = nil
+
+		m.MockRequestConstructor.EXPECT().
+			MultipartRequest(gomock.Any(), gomock.Any()).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(migratedResp, nil)
+
+		_, err := m.Bot.SendMediaGroup(context.Background(), &SendMediaGroupParams{
+			ChatID: ChatID{ID: -1},
+			Media:  []InputMedia{&InputMediaPhoto{Type: MediaTypePhoto, Media: testInputFile}},
+		})
+		require.ErrorIs(t, err, ta.ErrChatMigrated)
+		assert.Equal(t, []chatMigration{{oldChatID: -1, newChatID: -1002}}, migrations)
+	})
+
+	t.Run("success_file_by_id", func(t *testing.T) {
+		migrations = nil
+
+		gomock.InOrder(
+			m.MockRequestConstructor.EXPECT().
+				JSONRequest(gomock.Any()).
+				Return(data, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(migratedResp, nil),
+			m.MockRequestConstructor.EXPECT().
+				JSONRequest(&SendDocumentParams{ChatID: ChatID{ID: -1002}, Document: InputFile{FileID: "file"}}).
+				Return(data, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, &Message{MessageID: 1}), nil),
+		)
+
+		msg, err := m.Bot.SendDocument(context.Background(), &SendDocumentParams{
+			ChatID:   ChatID{ID: -1},
+			Document: InputFile{FileID: "file"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, msg.MessageID)
+		assert.Equal(t, []chatMigration{{oldChatID: -1, newChatID: -1002}}, migrations)
+	})
+
+	t.Run("error_retry", func(t *testing.T) {
+		migrations = nil
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(migratedResp, nil)
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(nil, errTest)
+
+		_, err := m.Bot.SendMessage(context.Background(), &SendMessageParams{ChatID: ChatID{ID: -1}})
+		require.ErrorIs(t, err, errTest)
+		assert.Len(t, migrations, 1)
+	})
+
+	t.Run("not_migrated", func(t *testing.T) {
+		migrations = nil
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 400}}, nil)
+
+		_, err := m.Bot.SendMessage(context.Background(), &SendMessageParams{ChatID: ChatID{ID: -1}})
+		require.Error(t, err)
+		assert.Empty(t, migrations)
+	})
+}
+
+func Test_withParametersChatID(t *testing.T) {
+	params := &SendMessageParams{ChatID: ChatID{ID: 1}, Text: "test"}
+
+	migrated, ok := withParametersChatID(params, ChatID{ID: 2})
+	require.True(t, ok)
+	assert.Equal(t, &SendMessageParams{ChatID: ChatID{ID: 2}, Text: "test"}, migrated)
+	assert.Equal(t, ChatID{ID: 1}, params.ChatID)
+
+	_, ok = withParametersChatID(&GetUpdatesParams{}, ChatID{ID: 2})
+	assert.False(t, ok)
+
+	_, ok = withParametersChatID(nil, ChatID{ID: 2})
+	assert.False(t, ok)
+
+	_, ok = withParametersChatID((*SendMessageParams)(nil), ChatID{ID: 2})
+	assert.False(t, ok)
+}
+
+func TestBot_handleChatMigrationUpdate(t *testing.T) {
+	var migrations []chatMigration
+	bot := &Bot{}
+
+	bot.handleChatMigrationUpdate(Update{Message: &Message{MigrateToChatID: -1002}})
+
+	bot.chatMigrated = func(oldChatID, newChatID int64) {
+		migrations = append(migrations, chatMigration{oldChatID: oldChatID, newChatID: newChatID})
+	}
+
+	bot.handleChatMigrationUpdate(Update{})
+	bot.handleChatMigrationUpdate(Update{Message: &Message{Chat: Chat{ID: -1}}})
+	bot.handleChatMigrationUpdate(Update{Message: &Message{Chat: Chat{ID: -1}, MigrateToChatID: -1002}})
+	bot.handleChatMigrationUpdate(Update{Message: &Message{Chat: Chat{ID: -1002}, MigrateFromChatID: -1}})
+
+	assert.Equal(t, []chatMigration{
+		{oldChatID: -1, newChatID: -1002},
+		{oldChatID: -1, newChatID: -1002},
+	}, migrations)
+}
@@ -83,7 +83,7 @@ Simple echo bot:
 				chatID := tu.ID(update.Message.Chat.ID)
 
 				// Copy sent message back to user
-				_, _ = bot.CopyMessage(&telego.CopyMessageParams{
+				_, _ = bot.CopyMessage(update.Context(), &telego.CopyMessageParams{
 					ChatID:     chatID,
 					FromChatID: chatID,
 					MessageID:  update.Message.MessageID,
@@ -0,0 +1,184 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+// ErrDownloadSizeMismatch returned when size of downloaded file doesn't match size reported by Telegram
+var ErrDownloadSizeMismatch = errors.New("telego: downloaded file size mismatch")
+
+// downloadContext represents configuration of file download
+type downloadContext struct {
+	offset  int64
+	maxSize int64
+	resumes int
+}
+
+// DownloadOption represents an option that can be applied to file download
+type DownloadOption func(ctx *downloadContext) error
+
+// WithDownloadOffset sets number of bytes of file that are already downloaded (for example, size of partially written
+// file), download continues from that offset using range request.
+// Default is 0.
+func WithDownloadOffset(offset int64) DownloadOption {
+	return func(ctx *downloadContext) error {
+		if offset < 0 {
+			return fmt.Errorf("offset is negative: %d", offset)
+		}
+
+		ctx.offset = offset
+		return nil
+	}
+}
+
+// WithDownloadMaxSize sets maximum size of file in bytes, download fails with [ErrFileTooLarge] if file is larger,
+// zero means no limit.
+// Default is [Bot.MaxDownloadSize].
+func WithDownloadMaxSize(maxSize int64) DownloadOption {
+	return func(ctx *downloadContext) error {
+		if maxSize < 0 {
+			return fmt.Errorf("max size is negative: %d", maxSize)
+		}
+
+		ctx.maxSize = maxSize
+		return nil
+	}
+}
+
+// WithDownloadResumes sets number of times interrupted download is resumed from the last written byte before
+// failing, useful for big files.
+// Default is 0.
+func WithDownloadResumes(resumes int) DownloadOption {
+	return func(ctx *downloadContext) error {
+		if resumes < 0 {
+			return fmt.Errorf("resumes are negative: %d", resumes)
+		}
+
+		ctx.resumes = resumes
+		return nil
+	}
+}
+
+// DownloadFile gets file info using [Bot.GetFile] and streams file contents to dst using bot's caller (so configured
+// HTTP client, proxy and test server path are respected), downloaded size is verified against [File.FileSize].
+// In local mode (see [WithLocalAPIServer]) files are copied directly from disk.
+//
+// Note: Caller must implement [ta.Downloader] (both default callers and decorators over them do), if download fails
+// part of the file may already be written to dst, use [WithDownloadOffset] to continue the download.
+func (b *Bot) DownloadFile(ctx context.Context, fileID string, dst io.Writer, options ...DownloadOption) (*File, error) {
+	downloadCtx := &downloadContext{
+		maxSize: b.MaxDownloadSize(),
+	}
+	for _, option := range options {
+		if err := option(downloadCtx); err != nil {
+			return nil, fmt.Errorf("telego: download file: options: %w", err)
+		}
+	}
+
+	file, err := b.GetFile(ctx, &GetFileParams{FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+
+	if file.FilePath == "" {
+		return nil, errors.New("telego: download file: file path is empty")
+	}
+
+	if downloadCtx.maxSize > 0 && file.FileSize > downloadCtx.maxSize {
+		return nil, fmt.Errorf("%w: file has %d bytes, limit is %d bytes", ErrFileTooLarge,
+			file.FileSize, downloadCtx.maxSize)
+	}
+
+	offset := downloadCtx.offset
+	if file.FileSize > 0 && offset >= file.FileSize {
+		if offset > file.FileSize {
+			return nil, fmt.Errorf("telego: download file: offset %d is beyond file size %d", offset, file.FileSize)
+		}
+		return file, nil
+	}
+
+	writer := &downloadWriter{
+		writer:  dst,
+		written: offset,
+		maxSize: downloadCtx.maxSize,
+	}
+
+	for attempt := 0; ; attempt++ {
+		var n int64
+		n, err = b.downloadFile(ctx, file.FilePath, offset, writer)
+		offset += n
+		if err == nil {
+			break
+		}
+
+		if errors.Is(err, ErrFileTooLarge) || ctx.Err() != nil || attempt >= downloadCtx.resumes {
+			return nil, fmt.Errorf("telego: download file: %w", err)
+		}
+
+		b.logAttrs(ctx, slog.LevelWarn, "Resuming file download",
+			slog.String(LogKeyFileID, fileID),
+			slog.Int64(LogKeyOffset, offset),
+			slog.Any(LogKeyError, err),
+		)
+	}
+
+	if file.FileSize > 0 && offset != file.FileSize {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d bytes", ErrDownloadSizeMismatch, file.FileSize, offset)
+	}
+
+	return file, nil
+}
+
+// downloadFile downloads file by its path starting from offset
+func (b *Bot) downloadFile(ctx context.Context, filePath string, offset int64, dst io.Writer) (int64, error) {
+	if b.localMode && isAbsFilePath(filePath) {
+		return copyLocalFile(filePath, offset, dst)
+	}
+
+	return ta.Download(ctx, b.api, b.FileDownloadURL(filePath), offset, dst)
+}
+
+// copyLocalFile copies file from disk starting from offset
+func copyLocalFile(filePath string, offset int64, dst io.Writer) (int64, error) {
+	file, err := os.Open(filePath) //nolint:gosec
+	if err != nil {
+		return 0, fmt.Errorf("open local file: %w", err)
+	}
+	defer func() { _ = file.Close() }() //nolint:errcheck
+
+	if offset > 0 {
+		if _, err = file.Seek(offset, io.SeekStart); err != nil {
+			return 0, fmt.Errorf("seek local file: %w", err)
+		}
+	}
+
+	n, err := io.Copy(dst, file)
+	if err != nil {
+		return n, fmt.Errorf("copy local file: %w", err)
+	}
+	return n, nil
+}
+
+// downloadWriter writer that limits total size of downloaded file
+type downloadWriter struct {
+	writer  io.Writer
+	written int64
+	maxSize int64
+}
+
+func (w *downloadWriter) Write(p []byte) (int, error) {
+	if w.maxSize > 0 && w.written+int64(len(p)) > w.maxSize {
+		return 0, fmt.Errorf("%w: limit is %d bytes", ErrFileTooLarge, w.maxSize)
+	}
+
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
@@ -0,0 +1,234 @@
+package telego
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mymmrac/telego/internal/json"
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+const testFileContent = "Hello World, this is a test file"
+
+type downloadServer struct {
+	t           *testing.T
+	file        File
+	content     string
+	interrupted atomic.Int32
+	interrupts  int32
+}
+
+func (s *downloadServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case botPathPrefix + token + "/getFile":
+		result, err := json.Marshal(s.file)
+		assert.NoError(s.t, err)
+
+		resp, err := json.Marshal(ta.Response{Ok: true, Result: result})
+		assert.NoError(s.t, err)
+
+		_, _ = w.Write(resp)
+	case "/file" + botPathPrefix + token + "/" + s.file.FilePath:
+		if s.interrupted.Add(1) <= s.interrupts {
+			// Send only part of the file and break the connection
+			w.Header().Set("Content-Length", "1000")
+			_, _ = w.Write([]byte(s.content[:5]))
+			return
+		}
+
+		http.ServeContent(w, r, s.file.FilePath, time.Time{}, strings.NewReader(s.content))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newDownloadBot(t *testing.T, file File, content string, options ...BotOption) (*Bot, *downloadServer) {
+	t.Helper()
+
+	api := &downloadServer{t: t, file: file, content: content}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+
+	bot, err := NewBot(token, append([]BotOption{
+		WithAPIServer(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithDiscardLogger(),
+	}, options...)...)
+	require.NoError(t, err)
+
+	return bot, api
+}
+
+func TestBot_DownloadFile(t *testing.T) {
+	ctx := context.Background()
+	file := File{FileID: "id", FilePath: "documents/file.txt", FileSize: int64(len(testFileContent))}
+
+	t.Run("success", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent)
+
+		buf := &bytes.Buffer{}
+		downloaded, err := bot.DownloadFile(ctx, "id", buf)
+		require.NoError(t, err)
+		assert.Equal(t, &file, downloaded)
+		assert.Equal(t, testFileContent, buf.String())
+	})
+
+	t.Run("success_offset", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent)
+
+		buf := &bytes.Buffer{}
+		_, err := bot.DownloadFile(ctx, "id", buf, WithDownloadOffset(6))
+		require.NoError(t, err)
+		assert.Equal(t, testFileContent[6:], buf.String())
+	})
+
+	t.Run("success_already_downloaded", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent)
+
+		buf := &bytes.Buffer{}
+		_, err := bot.DownloadFile(ctx, "id", buf, WithDownloadOffset(file.FileSize))
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("success_resume", func(t *testing.T) {
+		bot, api := newDownloadBot(t, file, testFileContent)
+		api.interrupts = 2
+
+		buf := &bytes.Buffer{}
+		_, err := bot.DownloadFile(ctx, "id", buf, WithDownloadResumes(2))
+		require.NoError(t, err)
+		assert.Equal(t, testFileContent, buf.String())
+	})
+
+	t.Run("success_local", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "file.txt")
+		require.NoError(t, os.WriteFile(filePath, []byte(testFileContent), 0o600))
+
+		localFile := File{FileID: "id", FilePath: filePath, FileSize: int64(len(testFileContent))}
+		bot, _ := newDownloadBot(t, localFile, "")
+		bot.localMode = true
+
+		buf := &bytes.Buffer{}
+		_, err := bot.DownloadFile(ctx, "id", buf, WithDownloadOffset(6))
+		require.NoError(t, err)
+		assert.Equal(t, testFileContent[6:], buf.String())
+	})
+
+	t.Run("error_interrupted", func(t *testing.T) {
+		bot, api := newDownloadBot(t, file, testFileContent)
+		api.interrupts = 2
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadResumes(1))
+		require.Error(t, err)
+	})
+
+	t.Run("error_too_large", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent)
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadMaxSize(5))
+		require.ErrorIs(t, err, ErrFileTooLarge)
+	})
+
+	t.Run("error_too_large_unknown_size", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, File{FileID: "id", FilePath: file.FilePath}, testFileContent)
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadMaxSize(5), WithDownloadResumes(1))
+		require.ErrorIs(t, err, ErrFileTooLarge)
+	})
+
+	t.Run("error_size_mismatch", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent[:10])
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{})
+		require.ErrorIs(t, err, ErrDownloadSizeMismatch)
+	})
+
+	t.Run("error_offset", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent)
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadOffset(file.FileSize+1))
+		require.Error(t, err)
+	})
+
+	t.Run("error_empty_path", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, File{FileID: "id"}, testFileContent)
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{})
+		require.Error(t, err)
+	})
+
+	t.Run("error_options", func(t *testing.T) {
+		bot, _ := newDownloadBot(t, file, testFileContent)
+
+		_, err := bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadOffset(-1))
+		require.Error(t, err)
+		_, err = bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadMaxSize(-1))
+		require.Error(t, err)
+		_, err = bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadResumes(-1))
+		require.Error(t, err)
+	})
+
+	t.Run("error_not_supported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().JSONRequest(gomock.Any()).Return(data, nil)
+		m.MockAPICaller.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any()).Return(telegoResponse(t, file), nil)
+
+		_, err := m.Bot.DownloadFile(ctx, "id", &bytes.Buffer{})
+		require.ErrorIs(t, err, ta.ErrDownloadNotSupported)
+	})
+}
+
+func TestBot_DownloadFile_defaultCaller(t *testing.T) {
+	file := File{FileID: "id", FilePath: "documents/file.txt"}
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case botPathPrefix + token + "/getFile":
+			result, err := json.Marshal(file)
+			assert.NoError(t, err)
+
+			resp, err := json.Marshal(ta.Response{Ok: true, Result: result})
+			assert.NoError(t, err)
+
+			_, _ = w.Write(resp)
+		case "/file" + botPathPrefix + token + "/" + file.FilePath:
+			// Send part of the file and never finish it, so download succeeds only if body is streamed
+			_, _ = w.Write(bytes.Repeat([]byte{'a'}, 1024))
+			w.(http.Flusher).Flush()
+
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	bot, err := NewBot(token, WithAPIServer(srv.URL), WithDiscardLogger())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	_, err = bot.DownloadFile(ctx, "id", &bytes.Buffer{}, WithDownloadMaxSize(512), WithDownloadResumes(0))
+	require.ErrorIs(t, err, ErrFileTooLarge)
+}
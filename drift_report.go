@@ -0,0 +1,151 @@
+package telego
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/mymmrac/telego/internal/json"
+)
+
+// DriftReport describes parts of an update that are not known to the current version of Telego, usually it means
+// that Telegram released a new Bot API version that is not yet supported
+type DriftReport struct {
+	// UnknownFields - JSON paths of fields that are present in the raw update, but are not known to Telego
+	UnknownFields []string
+
+	// UnknownVariants - JSON paths of union values decoded into Unknown* types (like [UnknownMessageOrigin]) mapped
+	// to their type, status or source as received from Telegram
+	UnknownVariants map[string]string
+}
+
+// Empty returns true if report has no unknown fields or variants
+func (r DriftReport) Empty() bool {
+	return len(r.UnknownFields) == 0 && len(r.UnknownVariants) == 0
+}
+
+// DriftReport compares update's raw JSON (see [Update.Raw]) with its decoded value and reports fields and union
+// variants that are unknown to Telego. Report is empty if update was not decoded from JSON.
+//
+// Note: Computing the report requires parsing the raw JSON once more, so it's recommended to use it only for
+// diagnostics (for example, logging reports in middleware).
+func (u Update) DriftReport() DriftReport {
+	var report DriftReport
+	if len(u.raw) == 0 {
+		return report
+	}
+
+	parser := json.ParserPoll.Get()
+	defer json.ParserPoll.Put(parser)
+
+	value, err := parser.ParseBytes(u.raw)
+	if err != nil {
+		return report
+	}
+
+	report.UnknownVariants = make(map[string]string)
+	driftWalk(&report, "", value, reflect.ValueOf(u))
+	if len(report.UnknownVariants) == 0 {
+		report.UnknownVariants = nil
+	}
+
+	return report
+}
+
+// driftWalk recursively compares JSON value with decoded Go value
+func driftWalk(report *DriftReport, path string, value *fastjson.Value, v reflect.Value) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+
+		if variant, ok := v.Interface().(unknownVariant); ok {
+			report.UnknownVariants[path] = variant.unknownVariantType()
+			return
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		object, err := value.Object()
+		if err != nil {
+			return
+		}
+
+		fields := driftStructFields(v.Type())
+		object.Visit(func(key []byte, fieldValue *fastjson.Value) {
+			fieldPath := driftJoinPath(path, string(key))
+
+			index, ok := fields[string(key)]
+			if !ok {
+				report.UnknownFields = append(report.UnknownFields, fieldPath)
+				return
+			}
+
+			driftWalk(report, fieldPath, fieldValue, v.Field(index))
+		})
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+
+		array, err := value.Array()
+		if err != nil {
+			return
+		}
+
+		for i, elemValue := range array {
+			if i >= v.Len() {
+				return
+			}
+
+			driftWalk(report, path+"["+strconv.Itoa(i)+"]", elemValue, v.Index(i))
+		}
+	default:
+		// Other kinds have no nested fields
+	}
+}
+
+// driftJoinPath joins JSON path with field name
+func driftJoinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// driftFieldsCache caches JSON field name to struct field index mappings by type
+var driftFieldsCache sync.Map // map[reflect.Type]map[string]int
+
+// driftStructFields returns JSON field name to struct field index mapping of struct type
+func driftStructFields(t reflect.Type) map[string]int {
+	if fields, ok := driftFieldsCache.Load(t); ok {
+		return fields.(map[string]int) //nolint:forcetypeassert
+	}
+
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+
+		fields[name] = i
+	}
+
+	driftFieldsCache.Store(t, fields)
+	return fields
+}
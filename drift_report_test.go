@@ -0,0 +1,58 @@
+package telego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/telego/internal/json"
+)
+
+func TestUpdate_DriftReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		report DriftReport
+	}{
+		{
+			name:   "no_drift",
+			json:   `{"update_id":1,"message":{"message_id":2,"date":0,"chat":{"id":3,"type":"private"},"text":"ok"}}`,
+			report: DriftReport{},
+		},
+		{
+			name: "unknown_fields",
+			json: `{"update_id":1,"new_update":{},"message":{"message_id":2,"date":0,` +
+				`"chat":{"id":3,"type":"private","new_chat":1},"entities":[{"type":"bold","offset":0,"length":1},` +
+				`{"type":"bold","offset":0,"length":1,"new_entity":true}]}}`,
+			report: DriftReport{
+				UnknownFields: []string{"new_update", "message.chat.new_chat", "message.entities[1].new_entity"},
+			},
+		},
+		{
+			name: "unknown_variants",
+			json: `{"update_id":1,"message_reaction":{"chat":{"id":3,"type":"private"},"message_id":2,"date":0,` +
+				`"old_reaction":[{"type":"emoji","emoji":"👍"}],"new_reaction":[{"type":"test"}]}}`,
+			report: DriftReport{
+				UnknownVariants: map[string]string{"message_reaction.new_reaction[0]": "test"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var update Update
+			err := json.Unmarshal([]byte(tt.json), &update)
+			require.NoError(t, err)
+
+			report := update.DriftReport()
+			assert.ElementsMatch(t, tt.report.UnknownFields, report.UnknownFields)
+			assert.Equal(t, tt.report.UnknownVariants, report.UnknownVariants)
+			assert.Equal(t, tt.report.Empty(), report.Empty())
+		})
+	}
+
+	t.Run("not_decoded", func(t *testing.T) {
+		assert.True(t, Update{UpdateID: 1}.DriftReport().Empty())
+	})
+}
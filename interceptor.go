@@ -0,0 +1,86 @@
+package telego
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// APICall represents a call of Bot API method passed through interceptors
+type APICall struct {
+	// Method - Name of the API method (like sendMessage), can be changed by interceptors before calling next
+	Method string
+
+	// Parameters - Typed parameters of the method (like *SendMessageParams), nil if method has no parameters, can be
+	// changed by interceptors before calling next
+	Parameters any
+
+	// Latency - Time spent executing the request, set after the request is executed
+	Latency time.Duration
+
+	results     []any
+	resultIndex int
+}
+
+// Result returns decoded result of the call (like *Message or *bool), nil if call has no result or result was not
+// yet set
+func (c *APICall) Result() any {
+	if c.resultIndex < 0 || c.resultIndex >= len(c.results) {
+		return nil
+	}
+	return reflect.ValueOf(c.results[c.resultIndex]).Elem().Interface()
+}
+
+// SetResult sets result of the call, useful for interceptors that short-circuit the call (like caches) or
+// modify its result. Result type should match one of method result types (like *Message or *bool), non-pointer
+// values are also accepted for pointer result types (like bool for *bool).
+func (c *APICall) SetResult(result any) error {
+	resultValue := reflect.ValueOf(result)
+	if !resultValue.IsValid() {
+		return fmt.Errorf("telego: nil result can't be set for %s", c.Method)
+	}
+
+	for i, v := range c.results {
+		target := reflect.ValueOf(v)
+		if target.Kind() != reflect.Pointer || target.IsNil() {
+			continue
+		}
+
+		target = target.Elem()
+		switch {
+		case resultValue.Type().AssignableTo(target.Type()):
+			target.Set(resultValue)
+		case target.Kind() == reflect.Pointer && resultValue.Type().AssignableTo(target.Type().Elem()):
+			targetValue := reflect.New(target.Type().Elem())
+			targetValue.Elem().Set(resultValue)
+			target.Set(targetValue)
+		default:
+			continue
+		}
+
+		c.resultIndex = i
+		return nil
+	}
+
+	return fmt.Errorf("telego: result of type %T can't be set for %s", result, c.Method)
+}
+
+// APICallHandler represents a handler that executes API call
+type APICallHandler func(ctx context.Context, call *APICall) error
+
+// Interceptor represents a hook around every API call made by [Bot], interceptor can inspect or modify call before
+// and after calling next handler, or short-circuit the call by not calling next handler at all
+type Interceptor func(ctx context.Context, call *APICall, next APICallHandler) error
+
+// interceptedHandler returns API call handler wrapped in interceptors, first interceptor is called first
+func (b *Bot) interceptedHandler() APICallHandler {
+	handler := b.executeCall
+	for i := len(b.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := b.interceptors[i], handler
+		handler = func(ctx context.Context, call *APICall) error {
+			return interceptor(ctx, call, next)
+		}
+	}
+	return handler
+}
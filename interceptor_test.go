@@ -0,0 +1,154 @@
+package telego
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockapi "github.com/mymmrac/telego/telegoapi/mock"
+)
+
+func newInterceptedBot(t *testing.T, ctrl *gomock.Controller, interceptors ...Interceptor) mockedBot {
+	t.Helper()
+
+	mb := mockedBot{
+		MockAPICaller:          mockapi.NewMockCaller(ctrl),
+		MockRequestConstructor: mockapi.NewMockRequestConstructor(ctrl),
+	}
+
+	bot, err := NewBot(token,
+		WithAPICaller(mb.MockAPICaller),
+		WithRequestConstructor(mb.MockRequestConstructor),
+		WithDiscardLogger(),
+		WithInterceptors(interceptors...),
+	)
+	require.NoError(t, err)
+	mb.Bot = bot
+
+	return mb
+}
+
+func TestBot_interceptors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Run("success", func(t *testing.T) {
+		var order []string
+		var calls []*APICall
+
+		m := newInterceptedBot(t, ctrl,
+			func(ctx context.Context, call *APICall, next APICallHandler) error {
+				order = append(order, "first")
+				err := next(ctx, call)
+				calls = append(calls, call)
+				order = append(order, "first_after")
+				return err
+			},
+			func(ctx context.Context, call *APICall, next APICallHandler) error {
+				order = append(order, "second")
+				assert.Nil(t, call.Result())
+				return next(ctx, call)
+			},
+		)
+
+		expectedMessage := &Message{MessageID: 1}
+		params := &SendMessageParams{Text: "test"}
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(params).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(telegoResponse(t, expectedMessage), nil)
+
+		message, err := m.Bot.SendMessage(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, expectedMessage, message)
+
+		assert.Equal(t, []string{"first", "second", "first_after"}, order)
+		require.Len(t, calls, 1)
+		assert.Equal(t, "sendMessage", calls[0].Method)
+		assert.Equal(t, params, calls[0].Parameters)
+		assert.Equal(t, expectedMessage, calls[0].Result())
+		assert.Positive(t, calls[0].Latency)
+	})
+
+	t.Run("modify_parameters", func(t *testing.T) {
+		m := newInterceptedBot(t, ctrl, func(ctx context.Context, call *APICall, next APICallHandler) error {
+			call.Parameters = &SendMessageParams{Text: "modified"}
+			return next(ctx, call)
+		})
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(&SendMessageParams{Text: "modified"}).
+			Return(data, nil)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(telegoResponse(t, &Message{}), nil)
+
+		_, err := m.Bot.SendMessage(context.Background(), &SendMessageParams{Text: "test"})
+		require.NoError(t, err)
+	})
+
+	t.Run("short_circuit", func(t *testing.T) {
+		expectedUser := &User{ID: 1}
+		m := newInterceptedBot(t, ctrl, func(_ context.Context, call *APICall, _ APICallHandler) error {
+			return call.SetResult(expectedUser)
+		})
+
+		user, err := m.Bot.GetMe(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, user)
+	})
+
+	t.Run("short_circuit_multiple_results", func(t *testing.T) {
+		var call *APICall
+		m := newInterceptedBot(t, ctrl, func(_ context.Context, c *APICall, _ APICallHandler) error {
+			call = c
+			return c.SetResult(true)
+		})
+
+		message, err := m.Bot.EditMessageText(context.Background(), &EditMessageTextParams{})
+		require.NoError(t, err)
+		assert.Nil(t, message)
+		assert.Equal(t, ToPtr(true), call.Result())
+	})
+
+	t.Run("error_short_circuit", func(t *testing.T) {
+		m := newInterceptedBot(t, ctrl, func(_ context.Context, _ *APICall, _ APICallHandler) error {
+			return errTest
+		})
+
+		_, err := m.Bot.GetMe(context.Background())
+		require.ErrorIs(t, err, errTest)
+	})
+
+	t.Run("error_set_result", func(t *testing.T) {
+		m := newInterceptedBot(t, ctrl, func(_ context.Context, call *APICall, _ APICallHandler) error {
+			require.Error(t, call.SetResult(nil))
+			return call.SetResult("test")
+		})
+
+		_, err := m.Bot.GetMe(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("error_call", func(t *testing.T) {
+		var callErr error
+		m := newInterceptedBot(t, ctrl, func(ctx context.Context, call *APICall, next APICallHandler) error {
+			callErr = next(ctx, call)
+			assert.Nil(t, call.Result())
+			return callErr
+		})
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(nil, errTest)
+
+		_, err := m.Bot.GetMe(context.Background())
+		require.ErrorIs(t, err, errTest)
+		assert.ErrorIs(t, err, callErr)
+	})
+}
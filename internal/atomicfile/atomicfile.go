@@ -0,0 +1,36 @@
+// Package atomicfile implements atomic writes of small files
+package atomicfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// WriteFile atomically replaces file with data by writing it to a temporary file in the same directory, syncing it to
+// disk and renaming it, directory of the file must exist
+func WriteFile(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return fmt.Errorf("write: %w", err)
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		_ = os.Remove(file.Name())
+		return fmt.Errorf("rename: %w", err)
+	}
+
+	return nil
+}
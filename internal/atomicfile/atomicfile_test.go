@@ -0,0 +1,35 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file")
+
+	require.NoError(t, WriteFile(path, []byte("1")))
+	require.NoError(t, WriteFile(path, []byte("2")))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(data))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.Error(t, WriteFile(filepath.Join(dir, "missing", "file"), []byte("1")))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "dir"), 0o750))
+	require.Error(t, WriteFile(filepath.Join(dir, "dir"), []byte("1")))
+
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
@@ -218,6 +218,7 @@ func writeMethods(file *os.File, methods tgMethods, currentMethods string) {
 	data.WriteString(`package telego
 
 import (
+	"context"
 	"fmt"
 
 	ta "github.com/mymmrac/telego/telegoapi"
@@ -231,10 +232,10 @@ import (
 
 	for _, m := range methods {
 		parametersStruct := m.nameTitle + "Params"
-		parametersArg := ""
+		parametersArg := "ctx context.Context"
 
 		if len(m.parameters) > 0 {
-			parametersArg = fmt.Sprintf("params *%s", parametersStruct)
+			parametersArg = fmt.Sprintf("ctx context.Context, params *%s", parametersStruct)
 
 			parametersStructDescription := fitTextToLine(fmt.Sprintf("%s - Represents parameters of %s method.",
 				parametersStruct, m.name), "// ")
@@ -315,18 +316,18 @@ import (
 			}
 
 			if len(m.parameters) > 0 {
-				data.WriteString(fmt.Sprintf("\terr := b.performRequest(\"%s\", params, &%s%s)\n", m.name, returnVar, successValue))
+				data.WriteString(fmt.Sprintf("\terr := b.performRequest(ctx, \"%s\", params, &%s%s)\n", m.name, returnVar, successValue))
 			} else {
-				data.WriteString(fmt.Sprintf("\terr := b.performRequest(\"%s\", nil, &%s%s)\n", m.name, returnVar, successValue))
+				data.WriteString(fmt.Sprintf("\terr := b.performRequest(ctx, \"%s\", nil, &%s%s)\n", m.name, returnVar, successValue))
 			}
 
 			data.WriteString(fmt.Sprintf("\tif err != nil {\n\t\treturn nil, fmt.Errorf(\"telego: %s(): %%w\", err)\n\t}\n\n", m.name))
 			data.WriteString(fmt.Sprintf("\treturn %s, nil\n}\n\n", returnVar))
 		} else {
 			if len(m.parameters) > 0 {
-				data.WriteString(fmt.Sprintf("\terr := b.performRequest(\"%s\", params)\n", m.name))
+				data.WriteString(fmt.Sprintf("\terr := b.performRequest(ctx, \"%s\", params)\n", m.name))
 			} else {
-				data.WriteString(fmt.Sprintf("\terr := b.performRequest(\"%s\", nil)\n", m.name))
+				data.WriteString(fmt.Sprintf("\terr := b.performRequest(ctx, \"%s\", nil)\n", m.name))
 			}
 
 			data.WriteString(fmt.Sprintf("\tif err != nil {\n\t\treturn fmt.Errorf(\"telego: %s(): %%w\", err)\n\t}\n\n", m.name))
@@ -14,6 +14,7 @@ func generateMethodsTests(methods tgMethods) {
 	data.WriteString(`package telego
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,14 +51,14 @@ import (
 			data.WriteString(fmt.Sprintf("\n\t\tresp := telegoResponse(t, %s)", expectedVar))
 		}
 
-		parameters := ""
+		parameters := "context.Background()"
 		if len(m.parameters) > 0 {
-			parameters = "nil"
+			parameters = "context.Background(), nil"
 		}
 
 		data.WriteString(`
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(` + respVar + `, nil)`)
 		data.WriteString("\n\n")
 
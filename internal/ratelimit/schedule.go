@@ -0,0 +1,86 @@
+// Package ratelimit implements pacing of events shared by rate limiters
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Schedule schedules events using generic cell rate algorithm
+// Note: Zero value is ready to use and doesn't limit events
+type Schedule struct {
+	interval time.Duration
+	burst    int
+	tat      time.Time // Theoretical arrival time of the next event
+}
+
+// SetLimit sets limit of count events per time period, burst is the number of events allowed at once without
+// waiting (1 used if not positive), events are not limited if count or period is not positive
+func (s *Schedule) SetLimit(count int, per time.Duration, burst int) {
+	s.interval = 0
+	if count > 0 && per > 0 {
+		s.interval = per / time.Duration(count)
+	}
+	s.burst = max(burst, 1)
+}
+
+// Reservation is a time slot reserved in [Schedule]
+type Reservation struct {
+	// AllowAt is the time when event is allowed
+	AllowAt time.Time
+
+	previous time.Time // Theoretical arrival time before reservation
+	tat      time.Time // Theoretical arrival time after reservation
+}
+
+// Reserve reserves time slot for event and returns the reservation
+func (s *Schedule) Reserve(now time.Time) Reservation {
+	if s.interval <= 0 {
+		return Reservation{AllowAt: now}
+	}
+
+	previous := s.tat
+	tat := s.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	s.tat = tat.Add(s.interval)
+
+	allowAt := tat.Add(-s.interval * time.Duration(s.burst-1))
+	if allowAt.Before(now) {
+		allowAt = now
+	}
+	return Reservation{AllowAt: allowAt, previous: previous, tat: s.tat}
+}
+
+// Cancel releases time slot of reservation if no other time slots were reserved after it, otherwise time slot is
+// kept reserved, so events reserved later are not allowed earlier than the limit permits
+func (s *Schedule) Cancel(r Reservation) {
+	if r.tat.IsZero() || !s.tat.Equal(r.tat) {
+		return
+	}
+	s.tat = r.previous
+}
+
+// Idle reports whether no time slots are reserved after now
+func (s *Schedule) Idle(now time.Time) bool {
+	return s.tat.Before(now)
+}
+
+// SleepUntil blocks until the specified time or until context is done
+func SleepUntil(ctx context.Context, t time.Time) error {
+	delay := time.Until(t)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Reserve(t *testing.T) {
+	now := time.Now()
+	s := Schedule{}
+	s.SetLimit(1, time.Second, 2)
+
+	assert.Equal(t, now, s.Reserve(now).AllowAt)
+	assert.Equal(t, now, s.Reserve(now).AllowAt)
+	assert.Equal(t, now.Add(time.Second), s.Reserve(now).AllowAt)
+	assert.Equal(t, now.Add(2*time.Second), s.Reserve(now).AllowAt)
+	assert.False(t, s.Idle(now))
+	assert.True(t, s.Idle(now.Add(5*time.Second)))
+
+	s = Schedule{}
+	assert.Equal(t, now, s.Reserve(now).AllowAt)
+	assert.Equal(t, now, s.Reserve(now).AllowAt)
+
+	s.SetLimit(0, time.Second, 0)
+	assert.Equal(t, now, s.Reserve(now).AllowAt)
+	assert.True(t, s.Idle(now))
+}
+
+func TestSchedule_Cancel(t *testing.T) {
+	now := time.Now()
+	s := Schedule{}
+	s.SetLimit(1, time.Second, 1)
+
+	assert.Equal(t, now, s.Reserve(now).AllowAt)
+	r := s.Reserve(now)
+	assert.Equal(t, now.Add(time.Second), r.AllowAt)
+
+	s.Cancel(r)
+	assert.Equal(t, now.Add(time.Second), s.Reserve(now).AllowAt)
+
+	r = s.Reserve(now)
+	assert.Equal(t, now.Add(2*time.Second), r.AllowAt)
+	last := s.Reserve(now)
+	assert.Equal(t, now.Add(3*time.Second), last.AllowAt)
+
+	s.Cancel(r)
+	assert.Equal(t, now.Add(4*time.Second), s.Reserve(now).AllowAt)
+
+	s = Schedule{}
+	s.SetLimit(1, time.Second, 1)
+	s.Cancel(s.Reserve(now))
+	assert.True(t, s.Idle(now))
+
+	s = Schedule{}
+	s.Cancel(s.Reserve(now))
+	assert.True(t, s.Idle(now))
+}
+
+func TestSleepUntil(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, SleepUntil(ctx, time.Now()))
+
+	start := time.Now()
+	require.NoError(t, SleepUntil(ctx, start.Add(time.Millisecond*10)))
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*10)
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, SleepUntil(canceledCtx, time.Now().Add(time.Hour)), context.Canceled)
+	require.ErrorIs(t, SleepUntil(canceledCtx, time.Now()), context.Canceled)
+}
@@ -0,0 +1,60 @@
+// Package watermark implements tracking of items that are processed out of order
+package watermark
+
+import (
+	"slices"
+	"sync"
+)
+
+// Tracker tracks pending items that are processed out of order, so that progress is advanced only over items that
+// were processed without gaps
+// Note: Zero value is ready to use
+type Tracker struct {
+	lock    sync.Mutex
+	pending []int
+	done    map[int]struct{}
+}
+
+// Add registers item as pending, items must be added in the order they should be processed in
+func (t *Tracker) Add(id int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.pending = append(t.pending, id)
+}
+
+// Done marks pending item as processed and removes leading processed items, returns the last removed item and true
+// if any items were removed, items that are not pending are ignored
+func (t *Tracker) Done(id int) (int, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.isPending(id) {
+		return 0, false
+	}
+
+	if t.done == nil {
+		t.done = make(map[int]struct{})
+	}
+	t.done[id] = struct{}{}
+
+	last, removed := 0, false
+	for len(t.pending) > 0 {
+		if _, ok := t.done[t.pending[0]]; !ok {
+			break
+		}
+		delete(t.done, t.pending[0])
+		last, removed = t.pending[0], true
+		t.pending = t.pending[1:]
+	}
+
+	return last, removed
+}
+
+// isPending reports whether item was added and not yet marked as processed, must be called with lock held
+func (t *Tracker) isPending(id int) bool {
+	if _, ok := t.done[id]; ok {
+		return false
+	}
+	return slices.Contains(t.pending, id)
+}
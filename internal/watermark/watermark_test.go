@@ -0,0 +1,34 @@
+package watermark
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := &Tracker{}
+
+	tracker.Add(10)
+	tracker.Add(11)
+	tracker.Add(13)
+
+	_, ok := tracker.Done(11)
+	assert.False(t, ok)
+
+	last, ok := tracker.Done(10)
+	assert.True(t, ok)
+	assert.Equal(t, 11, last)
+
+	_, ok = tracker.Done(10)
+	assert.False(t, ok)
+
+	_, ok = tracker.Done(12)
+	assert.False(t, ok)
+
+	last, ok = tracker.Done(13)
+	assert.True(t, ok)
+	assert.Equal(t, 13, last)
+	assert.Empty(t, tracker.pending)
+	assert.Empty(t, tracker.done)
+}
@@ -0,0 +1,94 @@
+package telego
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+// File size limits of Telegram Bot API server, more details: https://core.telegram.org/bots/api#using-a-local-bot-api-server
+const (
+	// DefaultMaxUploadSize maximum size of file that can be uploaded to Telegram Bot API server
+	DefaultMaxUploadSize int64 = 50 << 20 // 50 MB
+
+	// DefaultMaxDownloadSize maximum size of file that can be downloaded from Telegram Bot API server
+	DefaultMaxDownloadSize int64 = 20 << 20 // 20 MB
+
+	// LocalMaxUploadSize maximum size of file that can be uploaded to local Bot API server
+	LocalMaxUploadSize int64 = 2000 << 20 // 2000 MB
+)
+
+// localFileScheme URI scheme used to reference files on disk of local Bot API server
+const localFileScheme = "file://"
+
+// ErrFileTooLarge returned when file is larger than the upload limit of Bot API server and upload size check is enabled,
+// see [WithUploadSizeCheck]
+var ErrFileTooLarge = errors.New("telego: file is too large")
+
+// LocalMode returns true if bot uses local Bot API server (see [WithLocalAPIServer])
+func (b *Bot) LocalMode() bool {
+	return b.localMode
+}
+
+// MaxUploadSize returns maximum size of file that can be uploaded, zero means that limit is unknown (custom Bot API
+// server is used without local mode)
+func (b *Bot) MaxUploadSize() int64 {
+	switch {
+	case b.localMode:
+		return LocalMaxUploadSize
+	case b.apiURL == defaultBotAPIServer:
+		return DefaultMaxUploadSize
+	default:
+		return 0
+	}
+}
+
+// MaxDownloadSize returns maximum size of file that can be downloaded, zero means that there is no limit (local
+// mode) or limit is unknown (custom Bot API server is used without local mode)
+func (b *Bot) MaxDownloadSize() int64 {
+	if !b.localMode && b.apiURL == defaultBotAPIServer {
+		return DefaultMaxDownloadSize
+	}
+	return 0
+}
+
+// checkFilesSize returns error if upload size check is enabled and size of any file is known and exceeds upload limit
+func (b *Bot) checkFilesSize(files map[string]ta.NamedReader) error {
+	maxSize := b.MaxUploadSize()
+	if !b.checkUploadSize || maxSize <= 0 {
+		return nil
+	}
+
+	for field, file := range files {
+		if isNil(file) {
+			continue
+		}
+
+		size, ok := ta.ReaderSize(file)
+		if ok && size > maxSize {
+			return fmt.Errorf("%w: %q (%s) has %d bytes, limit is %d bytes", ErrFileTooLarge,
+				field, file.Name(), size, maxSize)
+		}
+	}
+
+	return nil
+}
+
+// isAbsFilePath returns true if path is absolute Unix or Windows path
+func isAbsFilePath(path string) bool {
+	if strings.HasPrefix(path, "/") {
+		return true
+	}
+	return len(path) >= 3 && path[1] == ':' && (path[2] == '\\' || path[2] == '/')
+}
+
+// localFileURI returns file URI of absolute path
+func localFileURI(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		// Windows path
+		path = "/" + strings.ReplaceAll(path, "\\", "/")
+	}
+	return localFileScheme + path
+}
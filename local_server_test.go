@@ -0,0 +1,112 @@
+package telego
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+type testSizedReader struct {
+	size int
+}
+
+func (r testSizedReader) Read(_ []byte) (int, error) {
+	panic("implement me")
+}
+
+func (r testSizedReader) Name() string {
+	return "test"
+}
+
+func (r testSizedReader) Len() int {
+	return r.size
+}
+
+func TestBot_SizeLimits(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		bot, err := NewBot(token)
+		require.NoError(t, err)
+
+		assert.False(t, bot.LocalMode())
+		assert.Equal(t, DefaultMaxUploadSize, bot.MaxUploadSize())
+		assert.Equal(t, DefaultMaxDownloadSize, bot.MaxDownloadSize())
+	})
+
+	t.Run("local", func(t *testing.T) {
+		bot, err := NewBot(token, WithLocalAPIServer("http://localhost:8081"))
+		require.NoError(t, err)
+
+		assert.True(t, bot.LocalMode())
+		assert.Equal(t, LocalMaxUploadSize, bot.MaxUploadSize())
+		assert.Zero(t, bot.MaxDownloadSize())
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		bot, err := NewBot(token, WithAPIServer("http://localhost:8081"))
+		require.NoError(t, err)
+
+		assert.False(t, bot.LocalMode())
+		assert.Zero(t, bot.MaxUploadSize())
+		assert.Zero(t, bot.MaxDownloadSize())
+	})
+}
+
+func TestBot_checkFilesSize(t *testing.T) {
+	bot, err := NewBot(token, WithUploadSizeCheck())
+	require.NoError(t, err)
+
+	files := map[string]ta.NamedReader{
+		"document": testSizedReader{size: int(DefaultMaxUploadSize)},
+		"nil":      nil,
+		"unknown":  testNamedReade{},
+	}
+	require.NoError(t, bot.checkFilesSize(files))
+
+	files["document"] = testSizedReader{size: int(DefaultMaxUploadSize) + 1}
+	require.ErrorIs(t, bot.checkFilesSize(files), ErrFileTooLarge)
+
+	bot.localMode = true
+	require.NoError(t, bot.checkFilesSize(files))
+
+	bot.localMode = false
+	bot.apiURL = "http://localhost:8081"
+	require.NoError(t, bot.checkFilesSize(files))
+
+	bot, err = NewBot(token)
+	require.NoError(t, err)
+	require.NoError(t, bot.checkFilesSize(files))
+}
+
+func TestBot_constructAndCallRequest_fileTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := newMockedBot(ctrl)
+	file := InputFile{File: testSizedReader{size: int(DefaultMaxUploadSize) + 1}}
+
+	m.MockRequestConstructor.EXPECT().
+		MultipartRequest(gomock.Any(), gomock.Any()).
+		Return(data, nil)
+	m.MockAPICaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(telegoResponse(t, &Message{}), nil)
+
+	_, err := m.Bot.SendDocument(context.Background(), &SendDocumentParams{Document: file})
+	require.NoError(t, err)
+
+	m.Bot.checkUploadSize = true
+	_, err = m.Bot.SendDocument(context.Background(), &SendDocumentParams{Document: file})
+	require.ErrorIs(t, err, ErrFileTooLarge)
+}
+
+func Test_localFileURI(t *testing.T) {
+	assert.True(t, isAbsFilePath("/file"))
+	assert.True(t, isAbsFilePath(`C:\file`))
+	assert.False(t, isAbsFilePath("documents/file"))
+
+	assert.Equal(t, "file:///path/to/file", localFileURI("/path/to/file"))
+	assert.Equal(t, "file:///C:/path/to/file", localFileURI(`C:\path\to\file`))
+}
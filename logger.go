@@ -1,8 +1,10 @@
 package telego
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"strings"
 	"sync"
@@ -19,12 +21,16 @@ type logMode string
 
 const (
 	debugMode logMode = "DEBUG"
+	infoMode  logMode = "INFO"
+	warnMode  logMode = "WARN"
 	errorMode logMode = "ERROR"
 
-	ansiReset  = "\u001B[0m"
-	ansiRed    = "\u001B[31m"
-	ansiYellow = "\u001B[33m"
-	ansiBlue   = "\u001B[34m"
+	ansiReset   = "\u001B[0m"
+	ansiRed     = "\u001B[31m"
+	ansiGreen   = "\u001B[32m"
+	ansiYellow  = "\u001B[33m"
+	ansiBlue    = "\u001B[34m"
+	ansiMagenta = "\u001B[35m"
 )
 
 type logger struct {
@@ -50,6 +56,10 @@ func (l *logger) prefix(mode logMode) string {
 	switch mode {
 	case debugMode:
 		return fmt.Sprintf("[%s] %sDEBUG%s ", timeNow, ansiYellow, ansiReset)
+	case infoMode:
+		return fmt.Sprintf("[%s] %sINFO%s ", timeNow, ansiGreen, ansiReset)
+	case warnMode:
+		return fmt.Sprintf("[%s] %sWARN%s ", timeNow, ansiMagenta, ansiReset)
 	case errorMode:
 		return fmt.Sprintf("[%s] %sERROR%s ", timeNow, ansiRed, ansiReset)
 	}
@@ -83,6 +93,34 @@ func (l *logger) Errorf(format string, args ...any) {
 	}
 }
 
+// Enabled reports whether logger is enabled for level, debug and info levels are enabled in debug mode, warning and
+// error levels are enabled if errors are printed
+func (l *logger) Enabled(_ context.Context, level slog.Level) bool {
+	if level >= slog.LevelWarn {
+		return l.PrintErrors
+	}
+	return l.DebugMode
+}
+
+// Log logs message with attributes formatted as key=value pairs
+func (l *logger) Log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if !l.Enabled(ctx, level) {
+		return
+	}
+
+	mode := debugMode
+	switch {
+	case level >= slog.LevelError:
+		mode = errorMode
+	case level >= slog.LevelWarn:
+		mode = warnMode
+	case level >= slog.LevelInfo:
+		mode = infoMode
+	}
+
+	l.log(mode, formatLogRecord(msg, attrs)+"\n")
+}
+
 // DefaultLoggerTokenReplacement used to replace bot token in logs when using default logger
 const DefaultLoggerTokenReplacement = "BOT_TOKEN"
 
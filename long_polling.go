@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"math/rand/v2"
 	"sync"
 	"time"
+
+	ta "github.com/mymmrac/telego/telegoapi"
 )
 
 const (
@@ -24,9 +28,56 @@ type longPollingContext struct {
 	stop        chan struct{}
 	ctx         context.Context
 
+	// requestCtx - Context of requests made by long polling, canceled on stop to abort in-flight requests
+	requestCtx     context.Context
+	cancelRequests context.CancelFunc
+
 	updateChanBuffer uint
 	updateInterval   time.Duration
 	retryTimeout     time.Duration
+	maxRetryTimeout  time.Duration
+	offsetStore      OffsetStore
+	errorHandler     func(err *LongPollingError)
+	isFatal          func(err error) bool
+	deleteWebhook    bool
+
+	// err - Fatal error that stopped long polling
+	err error
+}
+
+// LongPollingError represents an error of getting updates via long polling
+type LongPollingError struct {
+	// Err - Error returned by [Bot.GetUpdates] method
+	Err error
+
+	// Fatal - Long polling was stopped because of the error
+	Fatal bool
+
+	// Attempt - Number of consecutive failed attempts to get updates
+	Attempt int
+
+	// RetryIn - Delay before the next attempt, zero if error is fatal
+	RetryIn time.Duration
+}
+
+// Error returns error description
+func (e *LongPollingError) Error() string {
+	if e.Fatal {
+		return fmt.Sprintf("telego: long polling: fatal error on attempt %d: %s", e.Attempt, e.Err)
+	}
+	return fmt.Sprintf("telego: long polling: error on attempt %d, retry in %s: %s", e.Attempt, e.RetryIn, e.Err)
+}
+
+// Unwrap returns the original error
+func (e *LongPollingError) Unwrap() error {
+	return e.Err
+}
+
+// IsFatalLongPollingError reports whether long polling can't recover from the error by retrying: bot token is
+// invalid (401 or 404) or there is a conflict with another getUpdates request or an active webhook (409), can be used
+// with [WithLongPollingFatalErrors]
+func IsFatalLongPollingError(err error) bool {
+	return errors.Is(err, ta.ErrUnauthorized) || errors.Is(err, ta.ErrNotFound) || errors.Is(err, ta.ErrConflict)
 }
 
 // LongPollingOption represents an option that can be applied to longPollingContext
@@ -63,6 +114,59 @@ func WithLongPollingRetryTimeout(retryTimeout time.Duration) LongPollingOption {
 	}
 }
 
+// WithLongPollingRetryBackoff enables exponential backoff of retries, retry timeout (see
+// [WithLongPollingRetryTimeout]) is doubled after each consecutive error until max retry timeout is reached, up to 20%
+// of random jitter is added to each timeout.
+// By default, backoff is disabled and retry timeout is always the same.
+func WithLongPollingRetryBackoff(maxRetryTimeout time.Duration) LongPollingOption {
+	return func(ctx *longPollingContext) error {
+		if maxRetryTimeout < 0 {
+			return fmt.Errorf("max retry timeout is negative: %s", maxRetryTimeout)
+		}
+
+		ctx.maxRetryTimeout = maxRetryTimeout
+		return nil
+	}
+}
+
+// WithLongPollingErrorHandler sets handler of errors returned by [Bot.GetUpdates] method, it's called synchronously
+// before long polling retries or stops (if error is fatal)
+func WithLongPollingErrorHandler(handler func(err *LongPollingError)) LongPollingOption {
+	return func(ctx *longPollingContext) error {
+		if handler == nil {
+			return errors.New("error handler can't be nil")
+		}
+
+		ctx.errorHandler = handler
+		return nil
+	}
+}
+
+// WithLongPollingFatalErrors sets function that reports whether long polling should stop on the error, when long
+// polling stops, update chan is closed. Use [IsFatalLongPollingError] to stop on invalid token or conflict errors.
+// By default, no errors are fatal and all errors are retried.
+// Note: Conflict error (409) is returned when another getUpdates request is running (e.g. during rolling restarts),
+// so with [IsFatalLongPollingError] such conflicts stop long polling instead of being retried.
+func WithLongPollingFatalErrors(isFatal func(err error) bool) LongPollingOption {
+	return func(ctx *longPollingContext) error {
+		if isFatal == nil {
+			return errors.New("fatal errors function can't be nil")
+		}
+
+		ctx.isFatal = isFatal
+		return nil
+	}
+}
+
+// WithLongPollingDeleteWebhook makes long polling delete webhook (keeping pending updates) if getting updates fails
+// because webhook is still set, after that getting updates is retried immediately
+func WithLongPollingDeleteWebhook() LongPollingOption {
+	return func(ctx *longPollingContext) error {
+		ctx.deleteWebhook = true
+		return nil
+	}
+}
+
 // WithLongPollingBuffer sets buffering for update chan.
 // Default is 100.
 func WithLongPollingBuffer(chanBuffer uint) LongPollingOption {
@@ -72,10 +176,11 @@ func WithLongPollingBuffer(chanBuffer uint) LongPollingOption {
 	}
 }
 
-// WithLongPollingContext sets context used in long polling, this context will be added to each update
+// WithLongPollingContext sets context used in long polling, this context will be added to each update and used
+// for [Bot.GetUpdates] method calls
 //
-// Warning: Canceling the context doesn't stop long polling, it only closes update chan,
-// be sure to stop long polling by calling [Bot.StopLongPolling] method
+// Warning: Canceling the context doesn't stop long polling, it only cancels in-flight [Bot.GetUpdates] request and
+// closes update chan, be sure to stop long polling by calling [Bot.StopLongPolling] method
 func WithLongPollingContext(ctx context.Context) LongPollingOption {
 	return func(lCtx *longPollingContext) error {
 		if ctx == nil {
@@ -87,14 +192,33 @@ func WithLongPollingContext(ctx context.Context) LongPollingOption {
 	}
 }
 
+// WithLongPollingOffsetStore sets storage of offset, which makes long polling deliver updates at least once across
+// restarts. Offset is loaded from the store on start and committed only once all updates before it are marked as
+// processed using [Update.Done] method (bot handler from telegohandler package does it automatically), updates that
+// were not processed are delivered again after restart.
+// Note: Updates are not confirmed to Telegram until they are processed, so if updates are not marked as processed
+// long polling will stop receiving new updates once all updates returned by [Bot.GetUpdates] are pending.
+func WithLongPollingOffsetStore(store OffsetStore) LongPollingOption {
+	return func(ctx *longPollingContext) error {
+		if store == nil {
+			return errors.New("offset store can't be nil")
+		}
+
+		ctx.offsetStore = store
+		return nil
+	}
+}
+
 // UpdatesViaLongPolling receive updates in chan using the [Bot.GetUpdates] method.
 // Calling if already running (before [Bot.StopLongPolling] method) will return an error.
 // Note: After you done with getting updates, you should call [Bot.StopLongPolling] method which will close update chan.
+// Long polling also stops and closes update chan on fatal errors if they are enabled (see
+// [WithLongPollingFatalErrors]).
 //
 // Warning: If nil is passed as get update parameters, then the default timeout of 8s will be applied,
 // but if a non-nil parameter is passed, you should remember to explicitly specify timeout
 func (b *Bot) UpdatesViaLongPolling(params *GetUpdatesParams, options ...LongPollingOption) (<-chan Update, error) {
-	if b.longPollingContext != nil {
+	if b.IsRunningLongPolling() {
 		return nil, errors.New("telego: long polling context already exists")
 	}
 
@@ -103,29 +227,45 @@ func (b *Bot) UpdatesViaLongPolling(params *GetUpdatesParams, options ...LongPol
 		return nil, err
 	}
 
+	if params == nil {
+		params = &GetUpdatesParams{
+			Timeout: defaultLongPollingUpdateTimeoutInSeconds,
+		}
+	}
+
+	var tracker *offsetTracker
+	if ctx.offsetStore != nil {
+		tracker, err = newOffsetTracker(ctx.ctx, ctx.offsetStore)
+		if err != nil {
+			return nil, fmt.Errorf("telego: long polling: %w", err)
+		}
+		tracker.offset = max(tracker.offset, params.Offset)
+	}
+
 	ctx.runningLock.Lock()
 	defer ctx.runningLock.Unlock()
 
 	b.longPollingContext = ctx
 	ctx.stop = make(chan struct{})
+	ctx.requestCtx, ctx.cancelRequests = context.WithCancel(ctx.ctx)
 	ctx.running = true
 
 	updatesChan := make(chan Update, ctx.updateChanBuffer)
 
-	if params == nil {
-		params = &GetUpdatesParams{
-			Timeout: defaultLongPollingUpdateTimeoutInSeconds,
-		}
-	}
-
-	go b.doLongPolling(ctx, params, updatesChan)
+	go b.doLongPolling(ctx, params, tracker, updatesChan)
 
 	return updatesChan, nil
 }
 
-func (b *Bot) doLongPolling(ctx *longPollingContext, params *GetUpdatesParams, updatesChan chan<- Update) {
+func (b *Bot) doLongPolling(ctx *longPollingContext, params *GetUpdatesParams, tracker *offsetTracker,
+	updatesChan chan<- Update,
+) {
 	defer close(updatesChan)
 
+	nextUpdateID := params.Offset
+	var committed <-chan struct{}
+	attempt := 0
+
 	for {
 		select {
 		case <-ctx.stop:
@@ -136,19 +276,34 @@ func (b *Bot) doLongPolling(ctx *longPollingContext, params *GetUpdatesParams, u
 			// Continue getting updates
 		}
 
+		// With offset store only processed updates are confirmed, already delivered ones are skipped below
+		if tracker != nil {
+			params.Offset, committed = tracker.committed()
+		} else {
+			params.Offset = nextUpdateID
+		}
+
 		var updates []Update
-		updates, err := b.GetUpdates(params)
+		updates, err := b.GetUpdates(ctx.requestCtx, params)
 		if err != nil {
-			b.log.Errorf("Getting updates: %s", err)
-			b.log.Errorf("Retrying to get updates in %s", ctx.retryTimeout.String())
+			// Request was canceled because long polling was stopped or its context is done
+			if ctx.requestCtx.Err() != nil {
+				continue
+			}
 
-			time.Sleep(ctx.retryTimeout)
+			attempt++
+			if !b.handleLongPollingError(ctx, err, attempt) {
+				return
+			}
 			continue
 		}
+		attempt = 0
 
+		delivered := false
 		for _, update := range updates {
-			if update.UpdateID >= params.Offset {
-				params.Offset = update.UpdateID + 1
+			if update.UpdateID >= nextUpdateID {
+				nextUpdateID = update.UpdateID + 1
+				delivered = true
 
 				select {
 				case <-ctx.stop:
@@ -156,15 +311,125 @@ func (b *Bot) doLongPolling(ctx *longPollingContext, params *GetUpdatesParams, u
 				case <-ctx.ctx.Done():
 					return
 				default:
+					b.handleChatMigrationUpdate(update)
+					if tracker != nil {
+						tracker.deliver(update.UpdateID)
+						update.done = b.offsetDoneFunc(ctx.ctx, tracker, update.UpdateID)
+					}
 					if safeSend(updatesChan, update.WithContext(ctx.ctx)) {
-						b.log.Debugf("Long polling update chan closed")
+						b.logAttrs(ctx.ctx, slog.LevelDebug, "Long polling update chan closed",
+							slog.Int(LogKeyUpdateID, update.UpdateID))
 						return
 					}
 				}
 			}
 		}
 
-		time.Sleep(ctx.updateInterval)
+		// All received updates are still being processed, wait for them to be committed before getting updates
+		// again, otherwise the same updates will be returned immediately
+		if tracker != nil && !delivered && len(updates) > 0 {
+			select {
+			case <-ctx.stop:
+				return
+			case <-ctx.ctx.Done():
+				return
+			case <-committed:
+			}
+		}
+
+		if !ctx.wait(ctx.updateInterval) {
+			return
+		}
+	}
+}
+
+// handleLongPollingError handles error of getting updates, returns false if long polling should stop
+func (b *Bot) handleLongPollingError(ctx *longPollingContext, err error, attempt int) bool {
+	b.logAttrs(ctx.ctx, slog.LevelError, "Getting updates", slog.Any(LogKeyError, err))
+
+	// Webhook is deleted only once for each series of errors, to not delete webhook in a loop
+	if ctx.deleteWebhook && attempt == 1 && errors.Is(err, ta.ErrWebhookActive) {
+		deleteErr := b.DeleteWebhook(ctx.requestCtx, nil)
+		if deleteErr == nil {
+			b.logAttrs(ctx.ctx, slog.LevelWarn, "Webhook deleted to get updates via long polling")
+			if ctx.errorHandler != nil {
+				ctx.errorHandler(&LongPollingError{Err: err, Attempt: attempt})
+			}
+			return true
+		}
+		b.logAttrs(ctx.ctx, slog.LevelError, "Deleting webhook", slog.Any(LogKeyError, deleteErr))
+	}
+
+	lpErr := &LongPollingError{
+		Err:     err,
+		Fatal:   ctx.isFatal != nil && ctx.isFatal(err),
+		Attempt: attempt,
+	}
+	if !lpErr.Fatal {
+		lpErr.RetryIn = ctx.retryDelay(err, attempt)
+	}
+
+	if ctx.errorHandler != nil {
+		ctx.errorHandler(lpErr)
+	}
+
+	if lpErr.Fatal {
+		b.logAttrs(ctx.ctx, slog.LevelError, "Stopping long polling due to fatal error", slog.Any(LogKeyError, err))
+		b.stopLongPolling(ctx, lpErr)
+		return false
+	}
+
+	b.logAttrs(ctx.ctx, slog.LevelWarn, "Retrying to get updates", slog.Duration(LogKeyDuration, lpErr.RetryIn))
+	return ctx.wait(lpErr.RetryIn)
+}
+
+// wait waits for the specified duration, returns false if long polling was stopped or its context is done
+func (ctx *longPollingContext) wait(duration time.Duration) bool {
+	if duration <= 0 {
+		return ctx.requestCtx.Err() == nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.requestCtx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryDelay returns delay before the next attempt to get updates
+func (ctx *longPollingContext) retryDelay(err error, attempt int) time.Duration {
+	delay := ctx.retryTimeout
+	if ctx.maxRetryTimeout > ctx.retryTimeout {
+		for i := 1; i < attempt && delay < ctx.maxRetryTimeout; i++ {
+			delay *= 2
+		}
+		delay = min(delay, ctx.maxRetryTimeout)
+		delay += time.Duration(rand.Int64N(int64(delay/5) + 1)) //nolint:gosec
+	}
+
+	if retryAfter, ok := ta.RetryAfter(err); ok {
+		delay = max(delay, retryAfter)
+	}
+
+	return delay
+}
+
+// offsetDoneFunc returns function that marks update as processed and commits offset
+func (b *Bot) offsetDoneFunc(ctx context.Context, tracker *offsetTracker, updateID int) func() {
+	return func() {
+		saved, err := tracker.markDone(context.WithoutCancel(ctx), updateID)
+		if err != nil {
+			b.logAttrs(ctx, slog.LevelError, "Committing long polling offset", slog.Any(LogKeyError, err),
+				slog.Int(LogKeyUpdateID, updateID))
+			return
+		}
+		if saved {
+			b.logAttrs(ctx, slog.LevelDebug, "Long polling offset committed", slog.Int(LogKeyUpdateID, updateID))
+		}
 	}
 }
 
@@ -198,8 +463,9 @@ func (b *Bot) IsRunningLongPolling() bool {
 	return ctx.running
 }
 
-// StopLongPolling stop reviving updates from [Bot.UpdatesViaLongPolling] method, stopping is non-blocking, it closes
-// update chan, so it's caller's responsibility to process all unhandled updates after calling stop.
+// StopLongPolling stop reviving updates from [Bot.UpdatesViaLongPolling] method, stopping is non-blocking, it cancels
+// in-flight [Bot.GetUpdates] request and closes update chan, so it's caller's responsibility to process all unhandled
+// updates after calling stop.
 // Stop will only ensure that no more updates will come in update chan.
 // Calling [Bot.StopLongPolling] method multiple times will do nothing.
 func (b *Bot) StopLongPolling() {
@@ -208,12 +474,21 @@ func (b *Bot) StopLongPolling() {
 		return
 	}
 
+	b.stopLongPolling(ctx, nil)
+	b.longPollingContext = nil
+}
+
+// stopLongPolling stops long polling context, used also by long polling itself to stop on fatal error
+func (b *Bot) stopLongPolling(ctx *longPollingContext, err error) {
 	ctx.runningLock.Lock()
 	defer ctx.runningLock.Unlock()
 
 	if ctx.running {
+		ctx.err = err
 		close(ctx.stop)
+		if ctx.cancelRequests != nil {
+			ctx.cancelRequests()
+		}
 		ctx.running = false
-		b.longPollingContext = nil
 	}
 }
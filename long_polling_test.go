@@ -2,12 +2,16 @@ package telego
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
 )
 
 func TestBot_UpdatesViaLongPolling(t *testing.T) {
@@ -26,7 +30,7 @@ func TestBot_UpdatesViaLongPolling(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedUpdates)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil).MinTimes(1)
 
 		assert.NotPanics(t, func() {
@@ -93,7 +97,7 @@ func TestBot_UpdatesViaLongPolling(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedUpdates)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil).MinTimes(1)
 
 		assert.NotPanics(t, func() {
@@ -130,7 +134,7 @@ func TestBot_IsRunningLongPolling(t *testing.T) {
 
 		resp := telegoResponse(t, []Update{})
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil).AnyTimes()
 
 		_, err := m.Bot.UpdatesViaLongPolling(nil)
@@ -224,3 +228,463 @@ func TestWithLongPollingContext(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestBot_UpdatesViaLongPolling_OffsetStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Run("success", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		store := &MemoryOffsetStore{}
+		require.NoError(t, store.Save(context.Background(), 5))
+
+		offsets := make(chan int, 100)
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			DoAndReturn(func(params any) (*ta.RequestData, error) {
+				offsets <- params.(*GetUpdatesParams).Offset
+				return data, nil
+			}).MinTimes(1)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(telegoResponse(t, []Update{{UpdateID: 5}, {UpdateID: 6}}), nil).MinTimes(1)
+
+		updates, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingOffsetStore(store))
+		require.NoError(t, err)
+		defer m.Bot.StopLongPolling()
+
+		first := <-updates
+		second := <-updates
+		assert.Equal(t, 5, first.UpdateID)
+		assert.Equal(t, 6, second.UpdateID)
+
+		// Long polling waits for pending updates instead of requesting them again and again
+		assert.Equal(t, 5, <-offsets)
+		assert.Equal(t, 5, <-offsets)
+		time.Sleep(time.Millisecond * 50)
+		assert.Empty(t, offsets)
+
+		second.Done()
+		offset, err := store.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 5, offset)
+
+		first.Done()
+		assert.Equal(t, 7, <-offsets)
+
+		offset, err = store.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 7, offset)
+
+		select {
+		case update := <-updates:
+			t.Fatalf("unexpected update: %d", update.UpdateID)
+		default:
+		}
+	})
+
+	t.Run("error_load", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		_, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingOffsetStore(&FileOffsetStore{Path: t.TempDir()}))
+		require.Error(t, err)
+		assert.False(t, m.Bot.IsRunningLongPolling())
+	})
+}
+
+func TestWithLongPollingOffsetStore(t *testing.T) {
+	ctx := &longPollingContext{}
+
+	t.Run("success", func(t *testing.T) {
+		store := &MemoryOffsetStore{}
+		err := WithLongPollingOffsetStore(store)(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, store, ctx.offsetStore)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := WithLongPollingOffsetStore(nil)(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestBot_UpdatesViaLongPolling_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Run("fatal", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).Times(1)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 401, Description: "Unauthorized"}}, nil).
+			Times(1)
+
+		var errs []*LongPollingError
+		updates, err := m.Bot.UpdatesViaLongPolling(nil,
+			WithLongPollingFatalErrors(IsFatalLongPollingError),
+			WithLongPollingErrorHandler(func(err *LongPollingError) {
+				errs = append(errs, err)
+			}),
+		)
+		require.NoError(t, err)
+
+		_, ok := <-updates
+		assert.False(t, ok)
+		assert.False(t, m.Bot.IsRunningLongPolling())
+
+		require.Len(t, errs, 1)
+		assert.True(t, errs[0].Fatal)
+		assert.Equal(t, 1, errs[0].Attempt)
+		assert.Zero(t, errs[0].RetryIn)
+		require.ErrorIs(t, errs[0], ta.ErrUnauthorized)
+
+		m.Bot.StopLongPolling()
+	})
+
+	t.Run("transient", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).AnyTimes()
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errTest).Times(3)
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 401}}, nil).Times(1)
+
+		var errs []*LongPollingError
+		updates, err := m.Bot.UpdatesViaLongPolling(nil,
+			WithLongPollingRetryTimeout(time.Millisecond),
+			WithLongPollingRetryBackoff(time.Millisecond*3),
+			WithLongPollingFatalErrors(IsFatalLongPollingError),
+			WithLongPollingErrorHandler(func(err *LongPollingError) {
+				errs = append(errs, err)
+			}),
+		)
+		require.NoError(t, err)
+
+		for range updates {
+		}
+
+		require.Len(t, errs, 4)
+		for i, lpErr := range errs[:3] {
+			assert.False(t, lpErr.Fatal)
+			assert.Equal(t, i+1, lpErr.Attempt)
+			require.ErrorIs(t, lpErr, errTest)
+		}
+		assert.GreaterOrEqual(t, errs[0].RetryIn, time.Millisecond)
+		assert.GreaterOrEqual(t, errs[1].RetryIn, time.Millisecond*2)
+		assert.GreaterOrEqual(t, errs[2].RetryIn, time.Millisecond*3)
+		assert.True(t, errs[3].Fatal)
+	})
+
+	t.Run("delete_webhook", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).AnyTimes()
+
+		conflict := &ta.Response{Ok: false, Error: &ta.Error{
+			ErrorCode:   409,
+			Description: "Conflict: can't use getUpdates method while webhook is active",
+		}}
+
+		gomock.InOrder(
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(conflict, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, true), nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(conflict, nil),
+		)
+
+		var errs []*LongPollingError
+		updates, err := m.Bot.UpdatesViaLongPolling(nil,
+			WithLongPollingDeleteWebhook(),
+			WithLongPollingFatalErrors(IsFatalLongPollingError),
+			WithLongPollingErrorHandler(func(err *LongPollingError) {
+				errs = append(errs, err)
+			}),
+		)
+		require.NoError(t, err)
+
+		for range updates {
+		}
+
+		// Conflict right after deleting webhook is fatal
+		require.Len(t, errs, 2)
+		assert.False(t, errs[0].Fatal)
+		require.ErrorIs(t, errs[0], ta.ErrWebhookActive)
+		assert.True(t, errs[1].Fatal)
+		assert.Equal(t, 2, errs[1].Attempt)
+	})
+
+	t.Run("custom_fatal", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).Times(1)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errTest).Times(1)
+
+		updates, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingFatalErrors(func(err error) bool {
+			return errors.Is(err, errTest)
+		}))
+		require.NoError(t, err)
+
+		_, ok := <-updates
+		assert.False(t, ok)
+	})
+
+	t.Run("restart_after_fatal", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).AnyTimes()
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 404}}, nil).Times(2)
+
+		updates, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingFatalErrors(IsFatalLongPollingError))
+		require.NoError(t, err)
+		for range updates {
+		}
+
+		updates, err = m.Bot.UpdatesViaLongPolling(nil, WithLongPollingFatalErrors(IsFatalLongPollingError))
+		require.NoError(t, err)
+		for range updates {
+		}
+	})
+
+	t.Run("not_fatal_by_default", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).AnyTimes()
+
+		gomock.InOrder(
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 409, Description: "Conflict"}}, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, []Update{{UpdateID: 1}}), nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, []Update{}), nil).AnyTimes(),
+		)
+
+		var errs []*LongPollingError
+		updates, err := m.Bot.UpdatesViaLongPolling(nil,
+			WithLongPollingRetryTimeout(time.Millisecond),
+			WithLongPollingErrorHandler(func(err *LongPollingError) {
+				errs = append(errs, err)
+			}),
+		)
+		require.NoError(t, err)
+
+		update := <-updates
+		assert.Equal(t, 1, update.UpdateID)
+
+		m.Bot.StopLongPolling()
+		for range updates {
+		}
+
+		require.Len(t, errs, 1)
+		assert.False(t, errs[0].Fatal)
+		require.ErrorIs(t, errs[0], ta.ErrConflict)
+	})
+}
+
+func TestIsFatalLongPollingError(t *testing.T) {
+	assert.True(t, IsFatalLongPollingError(&ta.Error{ErrorCode: 401}))
+	assert.True(t, IsFatalLongPollingError(fmt.Errorf("wrapped: %w", &ta.Error{ErrorCode: 404})))
+	assert.True(t, IsFatalLongPollingError(&ta.Error{ErrorCode: 409}))
+	assert.False(t, IsFatalLongPollingError(&ta.Error{ErrorCode: 429}))
+	assert.False(t, IsFatalLongPollingError(&ta.Error{ErrorCode: 502}))
+	assert.False(t, IsFatalLongPollingError(errTest))
+}
+
+func TestLongPollingError(t *testing.T) {
+	lpErr := &LongPollingError{Err: errTest, Attempt: 2, RetryIn: time.Second}
+	assert.Contains(t, lpErr.Error(), "retry in 1s")
+	require.ErrorIs(t, lpErr, errTest)
+
+	lpErr = &LongPollingError{Err: errTest, Attempt: 1, Fatal: true}
+	assert.Contains(t, lpErr.Error(), "fatal")
+}
+
+func TestLongPollingContext_retryDelay(t *testing.T) {
+	t.Run("fixed", func(t *testing.T) {
+		ctx := &longPollingContext{retryTimeout: time.Second}
+		assert.Equal(t, time.Second, ctx.retryDelay(errTest, 1))
+		assert.Equal(t, time.Second, ctx.retryDelay(errTest, 10))
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		ctx := &longPollingContext{retryTimeout: time.Second, maxRetryTimeout: time.Second * 10}
+
+		delay := ctx.retryDelay(errTest, 3)
+		assert.GreaterOrEqual(t, delay, time.Second*4)
+		assert.LessOrEqual(t, delay, time.Second*4+time.Second*4/5)
+
+		delay = ctx.retryDelay(errTest, 100)
+		assert.GreaterOrEqual(t, delay, time.Second*10)
+		assert.LessOrEqual(t, delay, time.Second*12)
+	})
+
+	t.Run("retry_after", func(t *testing.T) {
+		ctx := &longPollingContext{retryTimeout: time.Second}
+		err := &ta.Error{ErrorCode: 429, Parameters: &ta.ResponseParameters{RetryAfter: 5}}
+		assert.Equal(t, time.Second*5, ctx.retryDelay(err, 1))
+	})
+}
+
+func TestLongPollingErrorOptions(t *testing.T) {
+	ctx := &longPollingContext{}
+
+	require.NoError(t, WithLongPollingRetryBackoff(time.Second)(ctx))
+	assert.Equal(t, time.Second, ctx.maxRetryTimeout)
+	require.Error(t, WithLongPollingRetryBackoff(-time.Second)(ctx))
+
+	require.NoError(t, WithLongPollingErrorHandler(func(*LongPollingError) {})(ctx))
+	assert.NotNil(t, ctx.errorHandler)
+	require.Error(t, WithLongPollingErrorHandler(nil)(ctx))
+
+	require.NoError(t, WithLongPollingFatalErrors(func(error) bool { return false })(ctx))
+	assert.NotNil(t, ctx.isFatal)
+	require.Error(t, WithLongPollingFatalErrors(nil)(ctx))
+
+	require.NoError(t, WithLongPollingDeleteWebhook()(ctx))
+	assert.True(t, ctx.deleteWebhook)
+}
+
+func TestBot_StopLongPolling_Immediate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	waitClosed := func(t *testing.T, updates <-chan Update) {
+		t.Helper()
+
+		select {
+		case _, ok := <-updates:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("long polling was not stopped immediately")
+		}
+	}
+
+	t.Run("in_flight_request", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).Times(1)
+
+		started := make(chan struct{})
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ *ta.RequestData) (*ta.Response, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).Times(1)
+
+		var errs []*LongPollingError
+		updates, err := m.Bot.UpdatesViaLongPolling(&GetUpdatesParams{Timeout: 50},
+			WithLongPollingErrorHandler(func(err *LongPollingError) {
+				errs = append(errs, err)
+			}),
+		)
+		require.NoError(t, err)
+
+		<-started
+		m.Bot.StopLongPolling()
+		waitClosed(t, updates)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("context_canceled", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).Times(1)
+
+		started := make(chan struct{})
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ *ta.RequestData) (*ta.Response, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).Times(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingContext(ctx))
+		require.NoError(t, err)
+
+		<-started
+		cancel()
+		waitClosed(t, updates)
+		m.Bot.StopLongPolling()
+	})
+
+	t.Run("update_interval", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).Times(1)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(telegoResponse(t, []Update{{UpdateID: 1}}), nil).Times(1)
+
+		updates, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingUpdateInterval(time.Hour))
+		require.NoError(t, err)
+
+		<-updates
+		m.Bot.StopLongPolling()
+		waitClosed(t, updates)
+	})
+
+	t.Run("retry_timeout", func(t *testing.T) {
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(nil, errTest).Times(1)
+
+		retrying := make(chan struct{})
+		updates, err := m.Bot.UpdatesViaLongPolling(nil, WithLongPollingRetryTimeout(time.Hour),
+			WithLongPollingErrorHandler(func(*LongPollingError) {
+				close(retrying)
+			}),
+		)
+		require.NoError(t, err)
+
+		<-retrying
+		m.Bot.StopLongPolling()
+		waitClosed(t, updates)
+	})
+}
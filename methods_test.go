@@ -1,6 +1,7 @@
 package telego
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,11 +26,16 @@ func TestBot_GetUpdates(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedUpdates)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		updates, err := m.Bot.GetUpdates(nil)
+		updates, err := m.Bot.GetUpdates(context.Background(), nil)
 		require.NoError(t, err)
+		require.Len(t, updates, len(expectedUpdates))
+		for i := range updates {
+			assert.NotEmpty(t, updates[i].Raw())
+			updates[i].raw = nil
+		}
 		assert.Equal(t, expectedUpdates, updates)
 	})
 
@@ -38,7 +44,7 @@ func TestBot_GetUpdates(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		updates, err := m.Bot.GetUpdates(nil)
+		updates, err := m.Bot.GetUpdates(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, updates)
 	})
@@ -54,10 +60,10 @@ func TestBot_SetWebhook(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetWebhook(nil)
+		err := m.Bot.SetWebhook(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -66,7 +72,7 @@ func TestBot_SetWebhook(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetWebhook(nil)
+		err := m.Bot.SetWebhook(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -81,10 +87,10 @@ func TestBot_DeleteWebhook(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteWebhook(nil)
+		err := m.Bot.DeleteWebhook(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -93,7 +99,7 @@ func TestBot_DeleteWebhook(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteWebhook(nil)
+		err := m.Bot.DeleteWebhook(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -112,10 +118,10 @@ func TestBot_GetWebhookInfo(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedWebhookInfo)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		webhookInfo, err := m.Bot.GetWebhookInfo()
+		webhookInfo, err := m.Bot.GetWebhookInfo(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, expectedWebhookInfo, webhookInfo)
 	})
@@ -125,7 +131,7 @@ func TestBot_GetWebhookInfo(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		webhookInfo, err := m.Bot.GetWebhookInfo()
+		webhookInfo, err := m.Bot.GetWebhookInfo(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, webhookInfo)
 	})
@@ -145,10 +151,10 @@ func TestBot_GetMe(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedUser)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		user, err := m.Bot.GetMe()
+		user, err := m.Bot.GetMe(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, expectedUser, user)
 	})
@@ -158,7 +164,7 @@ func TestBot_GetMe(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		user, err := m.Bot.GetMe()
+		user, err := m.Bot.GetMe(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, user)
 	})
@@ -174,10 +180,10 @@ func TestBot_LogOut(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.LogOut()
+		err := m.Bot.LogOut(context.Background())
 		require.NoError(t, err)
 	})
 
@@ -186,7 +192,7 @@ func TestBot_LogOut(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.LogOut()
+		err := m.Bot.LogOut(context.Background())
 		require.Error(t, err)
 	})
 }
@@ -201,10 +207,10 @@ func TestBot_Close(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.Close()
+		err := m.Bot.Close(context.Background())
 		require.NoError(t, err)
 	})
 
@@ -213,7 +219,7 @@ func TestBot_Close(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.Close()
+		err := m.Bot.Close(context.Background())
 		require.Error(t, err)
 	})
 }
@@ -229,10 +235,10 @@ func TestBot_SendMessage(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendMessage(nil)
+		message, err := m.Bot.SendMessage(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -242,7 +248,7 @@ func TestBot_SendMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendMessage(nil)
+		message, err := m.Bot.SendMessage(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -259,10 +265,10 @@ func TestBot_ForwardMessage(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.ForwardMessage(nil)
+		message, err := m.Bot.ForwardMessage(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -272,7 +278,7 @@ func TestBot_ForwardMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.ForwardMessage(nil)
+		message, err := m.Bot.ForwardMessage(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -293,10 +299,10 @@ func TestBot_ForwardMessages(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedMessageIDs)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		messageIDs, err := m.Bot.ForwardMessages(nil)
+		messageIDs, err := m.Bot.ForwardMessages(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessageIDs, messageIDs)
 	})
@@ -306,7 +312,7 @@ func TestBot_ForwardMessages(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		messageIDs, err := m.Bot.ForwardMessages(nil)
+		messageIDs, err := m.Bot.ForwardMessages(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, messageIDs)
 	})
@@ -326,10 +332,10 @@ func TestBot_CopyMessage(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedMessageID)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		messageID, err := m.Bot.CopyMessage(nil)
+		messageID, err := m.Bot.CopyMessage(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessageID, messageID)
 	})
@@ -339,7 +345,7 @@ func TestBot_CopyMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		messageID, err := m.Bot.CopyMessage(nil)
+		messageID, err := m.Bot.CopyMessage(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, messageID)
 	})
@@ -360,10 +366,10 @@ func TestBot_CopyMessages(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedMessageIDs)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		messageIDs, err := m.Bot.CopyMessages(nil)
+		messageIDs, err := m.Bot.CopyMessages(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessageIDs, messageIDs)
 	})
@@ -373,7 +379,7 @@ func TestBot_CopyMessages(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		messageIDs, err := m.Bot.CopyMessages(nil)
+		messageIDs, err := m.Bot.CopyMessages(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, messageIDs)
 	})
@@ -390,10 +396,10 @@ func TestBot_SendPhoto(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendPhoto(nil)
+		message, err := m.Bot.SendPhoto(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -403,7 +409,7 @@ func TestBot_SendPhoto(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendPhoto(nil)
+		message, err := m.Bot.SendPhoto(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -420,10 +426,10 @@ func TestBot_SendAudio(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendAudio(nil)
+		message, err := m.Bot.SendAudio(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -433,7 +439,7 @@ func TestBot_SendAudio(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendAudio(nil)
+		message, err := m.Bot.SendAudio(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -450,10 +456,10 @@ func TestBot_SendDocument(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendDocument(nil)
+		message, err := m.Bot.SendDocument(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -463,7 +469,7 @@ func TestBot_SendDocument(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendDocument(nil)
+		message, err := m.Bot.SendDocument(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -480,10 +486,10 @@ func TestBot_SendVideo(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendVideo(nil)
+		message, err := m.Bot.SendVideo(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -493,7 +499,7 @@ func TestBot_SendVideo(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendVideo(nil)
+		message, err := m.Bot.SendVideo(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -510,10 +516,10 @@ func TestBot_SendAnimation(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendAnimation(nil)
+		message, err := m.Bot.SendAnimation(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -523,7 +529,7 @@ func TestBot_SendAnimation(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendAnimation(nil)
+		message, err := m.Bot.SendAnimation(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -540,10 +546,10 @@ func TestBot_SendVoice(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendVoice(nil)
+		message, err := m.Bot.SendVoice(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -553,7 +559,7 @@ func TestBot_SendVoice(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendVoice(nil)
+		message, err := m.Bot.SendVoice(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -570,10 +576,10 @@ func TestBot_SendVideoNote(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendVideoNote(nil)
+		message, err := m.Bot.SendVideoNote(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -583,7 +589,7 @@ func TestBot_SendVideoNote(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendVideoNote(nil)
+		message, err := m.Bot.SendVideoNote(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -600,10 +606,10 @@ func TestBot_SendPaidMedia(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendPaidMedia(nil)
+		message, err := m.Bot.SendPaidMedia(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -613,7 +619,7 @@ func TestBot_SendPaidMedia(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendPaidMedia(nil)
+		message, err := m.Bot.SendPaidMedia(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -634,10 +640,10 @@ func TestBot_SendMediaGroup(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedMessages)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		messages, err := m.Bot.SendMediaGroup(nil)
+		messages, err := m.Bot.SendMediaGroup(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessages, messages)
 	})
@@ -647,7 +653,7 @@ func TestBot_SendMediaGroup(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		messages, err := m.Bot.SendMediaGroup(nil)
+		messages, err := m.Bot.SendMediaGroup(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, messages)
 	})
@@ -664,10 +670,10 @@ func TestBot_SendLocation(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendLocation(nil)
+		message, err := m.Bot.SendLocation(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -677,7 +683,7 @@ func TestBot_SendLocation(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendLocation(nil)
+		message, err := m.Bot.SendLocation(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -694,10 +700,10 @@ func TestBot_SendVenue(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendVenue(nil)
+		message, err := m.Bot.SendVenue(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -707,7 +713,7 @@ func TestBot_SendVenue(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendVenue(nil)
+		message, err := m.Bot.SendVenue(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -724,10 +730,10 @@ func TestBot_SendContact(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendContact(nil)
+		message, err := m.Bot.SendContact(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -737,7 +743,7 @@ func TestBot_SendContact(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendContact(nil)
+		message, err := m.Bot.SendContact(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -754,10 +760,10 @@ func TestBot_SendPoll(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendPoll(nil)
+		message, err := m.Bot.SendPoll(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -767,7 +773,7 @@ func TestBot_SendPoll(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendPoll(nil)
+		message, err := m.Bot.SendPoll(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -784,10 +790,10 @@ func TestBot_SendDice(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendDice(nil)
+		message, err := m.Bot.SendDice(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -797,7 +803,7 @@ func TestBot_SendDice(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendDice(nil)
+		message, err := m.Bot.SendDice(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -813,10 +819,10 @@ func TestBot_SendChatAction(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SendChatAction(nil)
+		err := m.Bot.SendChatAction(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -825,7 +831,7 @@ func TestBot_SendChatAction(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SendChatAction(nil)
+		err := m.Bot.SendChatAction(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -840,10 +846,10 @@ func TestBot_SetMessageReaction(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetMessageReaction(nil)
+		err := m.Bot.SetMessageReaction(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -852,7 +858,7 @@ func TestBot_SetMessageReaction(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetMessageReaction(nil)
+		err := m.Bot.SetMessageReaction(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -871,10 +877,10 @@ func TestBot_GetUserProfilePhotos(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedUserProfilePhotos)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		userProfilePhotos, err := m.Bot.GetUserProfilePhotos(nil)
+		userProfilePhotos, err := m.Bot.GetUserProfilePhotos(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedUserProfilePhotos, userProfilePhotos)
 	})
@@ -884,7 +890,7 @@ func TestBot_GetUserProfilePhotos(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		userProfilePhotos, err := m.Bot.GetUserProfilePhotos(nil)
+		userProfilePhotos, err := m.Bot.GetUserProfilePhotos(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, userProfilePhotos)
 	})
@@ -900,10 +906,10 @@ func TestBot_SetUserEmojiStatus(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetUserEmojiStatus(nil)
+		err := m.Bot.SetUserEmojiStatus(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -912,7 +918,7 @@ func TestBot_SetUserEmojiStatus(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetUserEmojiStatus(nil)
+		err := m.Bot.SetUserEmojiStatus(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -931,10 +937,10 @@ func TestBot_GetFile(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedFile)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		file, err := m.Bot.GetFile(nil)
+		file, err := m.Bot.GetFile(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedFile, file)
 	})
@@ -944,7 +950,7 @@ func TestBot_GetFile(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		file, err := m.Bot.GetFile(nil)
+		file, err := m.Bot.GetFile(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, file)
 	})
@@ -960,10 +966,10 @@ func TestBot_BanChatMember(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.BanChatMember(nil)
+		err := m.Bot.BanChatMember(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -972,7 +978,7 @@ func TestBot_BanChatMember(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.BanChatMember(nil)
+		err := m.Bot.BanChatMember(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -987,10 +993,10 @@ func TestBot_UnbanChatMember(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnbanChatMember(nil)
+		err := m.Bot.UnbanChatMember(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -999,7 +1005,7 @@ func TestBot_UnbanChatMember(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnbanChatMember(nil)
+		err := m.Bot.UnbanChatMember(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1014,10 +1020,10 @@ func TestBot_RestrictChatMember(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.RestrictChatMember(nil)
+		err := m.Bot.RestrictChatMember(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1026,7 +1032,7 @@ func TestBot_RestrictChatMember(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.RestrictChatMember(nil)
+		err := m.Bot.RestrictChatMember(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1041,10 +1047,10 @@ func TestBot_PromoteChatMember(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.PromoteChatMember(nil)
+		err := m.Bot.PromoteChatMember(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1053,7 +1059,7 @@ func TestBot_PromoteChatMember(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.PromoteChatMember(nil)
+		err := m.Bot.PromoteChatMember(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1068,10 +1074,10 @@ func TestBot_SetChatAdministratorCustomTitle(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatAdministratorCustomTitle(nil)
+		err := m.Bot.SetChatAdministratorCustomTitle(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1080,7 +1086,7 @@ func TestBot_SetChatAdministratorCustomTitle(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatAdministratorCustomTitle(nil)
+		err := m.Bot.SetChatAdministratorCustomTitle(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1095,10 +1101,10 @@ func TestBot_BanChatSenderChat(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.BanChatSenderChat(nil)
+		err := m.Bot.BanChatSenderChat(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1107,7 +1113,7 @@ func TestBot_BanChatSenderChat(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.BanChatSenderChat(nil)
+		err := m.Bot.BanChatSenderChat(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1122,10 +1128,10 @@ func TestBot_UnbanChatSenderChat(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnbanChatSenderChat(nil)
+		err := m.Bot.UnbanChatSenderChat(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1134,7 +1140,7 @@ func TestBot_UnbanChatSenderChat(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnbanChatSenderChat(nil)
+		err := m.Bot.UnbanChatSenderChat(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1149,10 +1155,10 @@ func TestBot_SetChatPermissions(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatPermissions(nil)
+		err := m.Bot.SetChatPermissions(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1161,7 +1167,7 @@ func TestBot_SetChatPermissions(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatPermissions(nil)
+		err := m.Bot.SetChatPermissions(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1178,10 +1184,10 @@ func TestBot_ExportChatInviteLink(t *testing.T) {
 		expectedInviteLink := "InviteLink"
 		resp := telegoResponse(t, expectedInviteLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		inviteLink, err := m.Bot.ExportChatInviteLink(nil)
+		inviteLink, err := m.Bot.ExportChatInviteLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, &expectedInviteLink, inviteLink)
 	})
@@ -1191,7 +1197,7 @@ func TestBot_ExportChatInviteLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		inviteLink, err := m.Bot.ExportChatInviteLink(nil)
+		inviteLink, err := m.Bot.ExportChatInviteLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, inviteLink)
 	})
@@ -1211,10 +1217,10 @@ func TestBot_CreateChatInviteLink(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatInviteLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatInviteLink, err := m.Bot.CreateChatInviteLink(nil)
+		chatInviteLink, err := m.Bot.CreateChatInviteLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatInviteLink, chatInviteLink)
 	})
@@ -1224,7 +1230,7 @@ func TestBot_CreateChatInviteLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatInviteLink, err := m.Bot.CreateChatInviteLink(nil)
+		chatInviteLink, err := m.Bot.CreateChatInviteLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatInviteLink)
 	})
@@ -1244,10 +1250,10 @@ func TestBot_EditChatInviteLink(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatInviteLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatInviteLink, err := m.Bot.EditChatInviteLink(nil)
+		chatInviteLink, err := m.Bot.EditChatInviteLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatInviteLink, chatInviteLink)
 	})
@@ -1257,7 +1263,7 @@ func TestBot_EditChatInviteLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatInviteLink, err := m.Bot.EditChatInviteLink(nil)
+		chatInviteLink, err := m.Bot.EditChatInviteLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatInviteLink)
 	})
@@ -1277,10 +1283,10 @@ func TestBot_CreateChatSubscriptionInviteLink(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatInviteLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatInviteLink, err := m.Bot.CreateChatSubscriptionInviteLink(nil)
+		chatInviteLink, err := m.Bot.CreateChatSubscriptionInviteLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatInviteLink, chatInviteLink)
 	})
@@ -1290,7 +1296,7 @@ func TestBot_CreateChatSubscriptionInviteLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatInviteLink, err := m.Bot.CreateChatSubscriptionInviteLink(nil)
+		chatInviteLink, err := m.Bot.CreateChatSubscriptionInviteLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatInviteLink)
 	})
@@ -1310,10 +1316,10 @@ func TestBot_EditChatSubscriptionInviteLink(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatInviteLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatInviteLink, err := m.Bot.EditChatSubscriptionInviteLink(nil)
+		chatInviteLink, err := m.Bot.EditChatSubscriptionInviteLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatInviteLink, chatInviteLink)
 	})
@@ -1323,7 +1329,7 @@ func TestBot_EditChatSubscriptionInviteLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatInviteLink, err := m.Bot.EditChatSubscriptionInviteLink(nil)
+		chatInviteLink, err := m.Bot.EditChatSubscriptionInviteLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatInviteLink)
 	})
@@ -1343,10 +1349,10 @@ func TestBot_RevokeChatInviteLink(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatInviteLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatInviteLink, err := m.Bot.RevokeChatInviteLink(nil)
+		chatInviteLink, err := m.Bot.RevokeChatInviteLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatInviteLink, chatInviteLink)
 	})
@@ -1356,7 +1362,7 @@ func TestBot_RevokeChatInviteLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatInviteLink, err := m.Bot.RevokeChatInviteLink(nil)
+		chatInviteLink, err := m.Bot.RevokeChatInviteLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatInviteLink)
 	})
@@ -1372,10 +1378,10 @@ func TestBot_ApproveChatJoinRequest(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.ApproveChatJoinRequest(nil)
+		err := m.Bot.ApproveChatJoinRequest(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1384,7 +1390,7 @@ func TestBot_ApproveChatJoinRequest(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.ApproveChatJoinRequest(nil)
+		err := m.Bot.ApproveChatJoinRequest(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1399,10 +1405,10 @@ func TestBot_DeclineChatJoinRequest(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeclineChatJoinRequest(nil)
+		err := m.Bot.DeclineChatJoinRequest(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1411,7 +1417,7 @@ func TestBot_DeclineChatJoinRequest(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeclineChatJoinRequest(nil)
+		err := m.Bot.DeclineChatJoinRequest(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1426,10 +1432,10 @@ func TestBot_SetChatPhoto(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatPhoto(nil)
+		err := m.Bot.SetChatPhoto(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1438,7 +1444,7 @@ func TestBot_SetChatPhoto(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatPhoto(nil)
+		err := m.Bot.SetChatPhoto(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1453,10 +1459,10 @@ func TestBot_DeleteChatPhoto(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteChatPhoto(nil)
+		err := m.Bot.DeleteChatPhoto(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1465,7 +1471,7 @@ func TestBot_DeleteChatPhoto(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteChatPhoto(nil)
+		err := m.Bot.DeleteChatPhoto(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1480,10 +1486,10 @@ func TestBot_SetChatTitle(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatTitle(nil)
+		err := m.Bot.SetChatTitle(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1492,7 +1498,7 @@ func TestBot_SetChatTitle(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatTitle(nil)
+		err := m.Bot.SetChatTitle(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1507,10 +1513,10 @@ func TestBot_SetChatDescription(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatDescription(nil)
+		err := m.Bot.SetChatDescription(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1519,7 +1525,7 @@ func TestBot_SetChatDescription(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatDescription(nil)
+		err := m.Bot.SetChatDescription(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1534,10 +1540,10 @@ func TestBot_PinChatMessage(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.PinChatMessage(nil)
+		err := m.Bot.PinChatMessage(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1546,7 +1552,7 @@ func TestBot_PinChatMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.PinChatMessage(nil)
+		err := m.Bot.PinChatMessage(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1561,10 +1567,10 @@ func TestBot_UnpinChatMessage(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnpinChatMessage(nil)
+		err := m.Bot.UnpinChatMessage(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1573,7 +1579,7 @@ func TestBot_UnpinChatMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnpinChatMessage(nil)
+		err := m.Bot.UnpinChatMessage(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1588,10 +1594,10 @@ func TestBot_UnpinAllChatMessages(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnpinAllChatMessages(nil)
+		err := m.Bot.UnpinAllChatMessages(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1600,7 +1606,7 @@ func TestBot_UnpinAllChatMessages(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnpinAllChatMessages(nil)
+		err := m.Bot.UnpinAllChatMessages(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1615,10 +1621,10 @@ func TestBot_LeaveChat(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.LeaveChat(nil)
+		err := m.Bot.LeaveChat(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1627,7 +1633,7 @@ func TestBot_LeaveChat(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.LeaveChat(nil)
+		err := m.Bot.LeaveChat(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1646,10 +1652,10 @@ func TestBot_GetChat(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatFullInfo)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatFullInfo, err := m.Bot.GetChat(nil)
+		chatFullInfo, err := m.Bot.GetChat(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatFullInfo, chatFullInfo)
 	})
@@ -1659,7 +1665,7 @@ func TestBot_GetChat(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatFullInfo, err := m.Bot.GetChat(nil)
+		chatFullInfo, err := m.Bot.GetChat(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatFullInfo)
 	})
@@ -1680,10 +1686,10 @@ func TestBot_GetChatAdministrators(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatMembers)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatMembers, err := m.Bot.GetChatAdministrators(nil)
+		chatMembers, err := m.Bot.GetChatAdministrators(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatMembers, chatMembers)
 	})
@@ -1693,7 +1699,7 @@ func TestBot_GetChatAdministrators(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatMembers, err := m.Bot.GetChatAdministrators(nil)
+		chatMembers, err := m.Bot.GetChatAdministrators(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatMembers)
 	})
@@ -1711,10 +1717,10 @@ func TestBot_GetChatMemberCount(t *testing.T) {
 		expectedChatMemberCount := 1
 		resp := telegoResponse(t, expectedChatMemberCount)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatMemberCount, err := m.Bot.GetChatMemberCount(nil)
+		chatMemberCount, err := m.Bot.GetChatMemberCount(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, &expectedChatMemberCount, chatMemberCount)
 	})
@@ -1724,7 +1730,7 @@ func TestBot_GetChatMemberCount(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		nt, err := m.Bot.GetChatMemberCount(nil)
+		nt, err := m.Bot.GetChatMemberCount(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, nt)
 	})
@@ -1744,10 +1750,10 @@ func TestBot_GetChatMember(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatMember)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatMember, err := m.Bot.GetChatMember(nil)
+		chatMember, err := m.Bot.GetChatMember(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatMember, chatMember)
 	})
@@ -1757,7 +1763,7 @@ func TestBot_GetChatMember(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatMember, err := m.Bot.GetChatMember(nil)
+		chatMember, err := m.Bot.GetChatMember(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatMember)
 	})
@@ -1773,10 +1779,10 @@ func TestBot_SetChatStickerSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatStickerSet(nil)
+		err := m.Bot.SetChatStickerSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1785,7 +1791,7 @@ func TestBot_SetChatStickerSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatStickerSet(nil)
+		err := m.Bot.SetChatStickerSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1800,10 +1806,10 @@ func TestBot_DeleteChatStickerSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteChatStickerSet(nil)
+		err := m.Bot.DeleteChatStickerSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1812,7 +1818,7 @@ func TestBot_DeleteChatStickerSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteChatStickerSet(nil)
+		err := m.Bot.DeleteChatStickerSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1831,10 +1837,10 @@ func TestBot_GetForumTopicIconStickers(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedStickers)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		stickers, err := m.Bot.GetForumTopicIconStickers()
+		stickers, err := m.Bot.GetForumTopicIconStickers(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, expectedStickers, stickers)
 	})
@@ -1844,7 +1850,7 @@ func TestBot_GetForumTopicIconStickers(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		stickers, err := m.Bot.GetForumTopicIconStickers()
+		stickers, err := m.Bot.GetForumTopicIconStickers(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, stickers)
 	})
@@ -1864,10 +1870,10 @@ func TestBot_CreateForumTopic(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedForumTopic)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		forumTopic, err := m.Bot.CreateForumTopic(nil)
+		forumTopic, err := m.Bot.CreateForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedForumTopic, forumTopic)
 	})
@@ -1877,7 +1883,7 @@ func TestBot_CreateForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		forumTopic, err := m.Bot.CreateForumTopic(nil)
+		forumTopic, err := m.Bot.CreateForumTopic(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, forumTopic)
 	})
@@ -1893,10 +1899,10 @@ func TestBot_EditForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.EditForumTopic(nil)
+		err := m.Bot.EditForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1905,7 +1911,7 @@ func TestBot_EditForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.EditForumTopic(nil)
+		err := m.Bot.EditForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1920,10 +1926,10 @@ func TestBot_CloseForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.CloseForumTopic(nil)
+		err := m.Bot.CloseForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1932,7 +1938,7 @@ func TestBot_CloseForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.CloseForumTopic(nil)
+		err := m.Bot.CloseForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1947,10 +1953,10 @@ func TestBot_ReopenForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.ReopenForumTopic(nil)
+		err := m.Bot.ReopenForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1959,7 +1965,7 @@ func TestBot_ReopenForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.ReopenForumTopic(nil)
+		err := m.Bot.ReopenForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -1974,10 +1980,10 @@ func TestBot_DeleteForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteForumTopic(nil)
+		err := m.Bot.DeleteForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -1986,7 +1992,7 @@ func TestBot_DeleteForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteForumTopic(nil)
+		err := m.Bot.DeleteForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2001,10 +2007,10 @@ func TestBot_UnpinAllForumTopicMessages(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnpinAllForumTopicMessages(nil)
+		err := m.Bot.UnpinAllForumTopicMessages(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2013,7 +2019,7 @@ func TestBot_UnpinAllForumTopicMessages(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnpinAllForumTopicMessages(nil)
+		err := m.Bot.UnpinAllForumTopicMessages(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2028,10 +2034,10 @@ func TestBot_EditGeneralForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.EditGeneralForumTopic(nil)
+		err := m.Bot.EditGeneralForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2040,7 +2046,7 @@ func TestBot_EditGeneralForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.EditGeneralForumTopic(nil)
+		err := m.Bot.EditGeneralForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2055,10 +2061,10 @@ func TestBot_CloseGeneralForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.CloseGeneralForumTopic(nil)
+		err := m.Bot.CloseGeneralForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2067,7 +2073,7 @@ func TestBot_CloseGeneralForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.CloseGeneralForumTopic(nil)
+		err := m.Bot.CloseGeneralForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2082,10 +2088,10 @@ func TestBot_ReopenGeneralForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.ReopenGeneralForumTopic(nil)
+		err := m.Bot.ReopenGeneralForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2094,7 +2100,7 @@ func TestBot_ReopenGeneralForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.ReopenGeneralForumTopic(nil)
+		err := m.Bot.ReopenGeneralForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2109,10 +2115,10 @@ func TestBot_HideGeneralForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.HideGeneralForumTopic(nil)
+		err := m.Bot.HideGeneralForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2121,7 +2127,7 @@ func TestBot_HideGeneralForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.HideGeneralForumTopic(nil)
+		err := m.Bot.HideGeneralForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2136,10 +2142,10 @@ func TestBot_UnhideGeneralForumTopic(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnhideGeneralForumTopic(nil)
+		err := m.Bot.UnhideGeneralForumTopic(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2148,7 +2154,7 @@ func TestBot_UnhideGeneralForumTopic(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnhideGeneralForumTopic(nil)
+		err := m.Bot.UnhideGeneralForumTopic(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2163,10 +2169,10 @@ func TestBot_UnpinAllGeneralForumTopicMessages(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.UnpinAllGeneralForumTopicMessages(nil)
+		err := m.Bot.UnpinAllGeneralForumTopicMessages(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2175,7 +2181,7 @@ func TestBot_UnpinAllGeneralForumTopicMessages(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.UnpinAllGeneralForumTopicMessages(nil)
+		err := m.Bot.UnpinAllGeneralForumTopicMessages(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2190,10 +2196,10 @@ func TestBot_AnswerCallbackQuery(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.AnswerCallbackQuery(nil)
+		err := m.Bot.AnswerCallbackQuery(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2202,7 +2208,7 @@ func TestBot_AnswerCallbackQuery(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.AnswerCallbackQuery(nil)
+		err := m.Bot.AnswerCallbackQuery(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2219,10 +2225,10 @@ func TestBot_GetUserChatBoosts(t *testing.T) {
 		expectedUserChatBoosts := &UserChatBoosts{}
 		resp := telegoResponse(t, expectedUserChatBoosts)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		userChatBoosts, err := m.Bot.GetUserChatBoosts(nil)
+		userChatBoosts, err := m.Bot.GetUserChatBoosts(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedUserChatBoosts, userChatBoosts)
 	})
@@ -2232,7 +2238,7 @@ func TestBot_GetUserChatBoosts(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		userChatBoosts, err := m.Bot.GetUserChatBoosts(nil)
+		userChatBoosts, err := m.Bot.GetUserChatBoosts(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, userChatBoosts)
 	})
@@ -2250,10 +2256,10 @@ func TestBot_GetBusinessConnection(t *testing.T) {
 		expectedBusinessConnection := &BusinessConnection{}
 		resp := telegoResponse(t, expectedBusinessConnection)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		businessConnection, err := m.Bot.GetBusinessConnection(nil)
+		businessConnection, err := m.Bot.GetBusinessConnection(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedBusinessConnection, businessConnection)
 	})
@@ -2263,7 +2269,7 @@ func TestBot_GetBusinessConnection(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		businessConnection, err := m.Bot.GetBusinessConnection(nil)
+		businessConnection, err := m.Bot.GetBusinessConnection(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, businessConnection)
 	})
@@ -2279,10 +2285,10 @@ func TestBot_SetMyCommands(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetMyCommands(nil)
+		err := m.Bot.SetMyCommands(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2291,7 +2297,7 @@ func TestBot_SetMyCommands(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetMyCommands(nil)
+		err := m.Bot.SetMyCommands(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2306,10 +2312,10 @@ func TestBot_DeleteMyCommands(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteMyCommands(nil)
+		err := m.Bot.DeleteMyCommands(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2318,7 +2324,7 @@ func TestBot_DeleteMyCommands(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteMyCommands(nil)
+		err := m.Bot.DeleteMyCommands(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2338,10 +2344,10 @@ func TestBot_GetMyCommands(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedBotCommands)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		botCommands, err := m.Bot.GetMyCommands(nil)
+		botCommands, err := m.Bot.GetMyCommands(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedBotCommands, botCommands)
 	})
@@ -2351,7 +2357,7 @@ func TestBot_GetMyCommands(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		botCommands, err := m.Bot.GetMyCommands(nil)
+		botCommands, err := m.Bot.GetMyCommands(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, botCommands)
 	})
@@ -2367,10 +2373,10 @@ func TestBot_SetMyName(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetMyName(nil)
+		err := m.Bot.SetMyName(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2379,7 +2385,7 @@ func TestBot_SetMyName(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetMyName(nil)
+		err := m.Bot.SetMyName(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2398,10 +2404,10 @@ func TestBot_GetMyName(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedBotName)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		botName, err := m.Bot.GetMyName(nil)
+		botName, err := m.Bot.GetMyName(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedBotName, botName)
 	})
@@ -2411,7 +2417,7 @@ func TestBot_GetMyName(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		botName, err := m.Bot.GetMyName(nil)
+		botName, err := m.Bot.GetMyName(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, botName)
 	})
@@ -2427,10 +2433,10 @@ func TestBot_SetMyDescription(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetMyDescription(nil)
+		err := m.Bot.SetMyDescription(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2439,7 +2445,7 @@ func TestBot_SetMyDescription(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetMyDescription(nil)
+		err := m.Bot.SetMyDescription(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2458,10 +2464,10 @@ func TestBot_GetMyDescription(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedBotDescription)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		botDescription, err := m.Bot.GetMyDescription(nil)
+		botDescription, err := m.Bot.GetMyDescription(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedBotDescription, botDescription)
 	})
@@ -2471,7 +2477,7 @@ func TestBot_GetMyDescription(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		botDescription, err := m.Bot.GetMyDescription(nil)
+		botDescription, err := m.Bot.GetMyDescription(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, botDescription)
 	})
@@ -2487,10 +2493,10 @@ func TestBot_SetMyShortDescription(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetMyShortDescription(nil)
+		err := m.Bot.SetMyShortDescription(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2499,7 +2505,7 @@ func TestBot_SetMyShortDescription(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetMyShortDescription(nil)
+		err := m.Bot.SetMyShortDescription(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2518,10 +2524,10 @@ func TestBot_GetMyShortDescription(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedBotShortDescription)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		botShortDescription, err := m.Bot.GetMyShortDescription(nil)
+		botShortDescription, err := m.Bot.GetMyShortDescription(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedBotShortDescription, botShortDescription)
 	})
@@ -2531,7 +2537,7 @@ func TestBot_GetMyShortDescription(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		botShortDescription, err := m.Bot.GetMyShortDescription(nil)
+		botShortDescription, err := m.Bot.GetMyShortDescription(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, botShortDescription)
 	})
@@ -2547,10 +2553,10 @@ func TestBot_SetChatMenuButton(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetChatMenuButton(nil)
+		err := m.Bot.SetChatMenuButton(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2559,7 +2565,7 @@ func TestBot_SetChatMenuButton(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetChatMenuButton(nil)
+		err := m.Bot.SetChatMenuButton(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2578,10 +2584,10 @@ func TestBot_GetChatMenuButton(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedMenuButton)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		menuButton, err := m.Bot.GetChatMenuButton(nil)
+		menuButton, err := m.Bot.GetChatMenuButton(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMenuButton, menuButton)
 	})
@@ -2591,7 +2597,7 @@ func TestBot_GetChatMenuButton(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		menuButton, err := m.Bot.GetChatMenuButton(nil)
+		menuButton, err := m.Bot.GetChatMenuButton(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, menuButton)
 	})
@@ -2607,10 +2613,10 @@ func TestBot_SetMyDefaultAdministratorRights(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetMyDefaultAdministratorRights(nil)
+		err := m.Bot.SetMyDefaultAdministratorRights(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2619,7 +2625,7 @@ func TestBot_SetMyDefaultAdministratorRights(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetMyDefaultAdministratorRights(nil)
+		err := m.Bot.SetMyDefaultAdministratorRights(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2638,10 +2644,10 @@ func TestBot_GetMyDefaultAdministratorRights(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedChatAdministratorRights)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		chatAdministratorRights, err := m.Bot.GetMyDefaultAdministratorRights(nil)
+		chatAdministratorRights, err := m.Bot.GetMyDefaultAdministratorRights(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedChatAdministratorRights, chatAdministratorRights)
 	})
@@ -2651,7 +2657,7 @@ func TestBot_GetMyDefaultAdministratorRights(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		chatAdministratorRights, err := m.Bot.GetMyDefaultAdministratorRights(nil)
+		chatAdministratorRights, err := m.Bot.GetMyDefaultAdministratorRights(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, chatAdministratorRights)
 	})
@@ -2668,10 +2674,10 @@ func TestBot_EditMessageText(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.EditMessageText(nil)
+		message, err := m.Bot.EditMessageText(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2681,7 +2687,7 @@ func TestBot_EditMessageText(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.EditMessageText(nil)
+		message, err := m.Bot.EditMessageText(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2698,10 +2704,10 @@ func TestBot_EditMessageCaption(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.EditMessageCaption(nil)
+		message, err := m.Bot.EditMessageCaption(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2711,7 +2717,7 @@ func TestBot_EditMessageCaption(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.EditMessageCaption(nil)
+		message, err := m.Bot.EditMessageCaption(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2728,10 +2734,10 @@ func TestBot_EditMessageMedia(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.EditMessageMedia(nil)
+		message, err := m.Bot.EditMessageMedia(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2741,7 +2747,7 @@ func TestBot_EditMessageMedia(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.EditMessageMedia(nil)
+		message, err := m.Bot.EditMessageMedia(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2758,10 +2764,10 @@ func TestBot_EditMessageLiveLocation(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.EditMessageLiveLocation(nil)
+		message, err := m.Bot.EditMessageLiveLocation(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2771,7 +2777,7 @@ func TestBot_EditMessageLiveLocation(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.EditMessageLiveLocation(nil)
+		message, err := m.Bot.EditMessageLiveLocation(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2788,10 +2794,10 @@ func TestBot_StopMessageLiveLocation(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.StopMessageLiveLocation(nil)
+		message, err := m.Bot.StopMessageLiveLocation(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2801,7 +2807,7 @@ func TestBot_StopMessageLiveLocation(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.StopMessageLiveLocation(nil)
+		message, err := m.Bot.StopMessageLiveLocation(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2818,10 +2824,10 @@ func TestBot_EditMessageReplyMarkup(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.EditMessageReplyMarkup(nil)
+		message, err := m.Bot.EditMessageReplyMarkup(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2831,7 +2837,7 @@ func TestBot_EditMessageReplyMarkup(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.EditMessageReplyMarkup(nil)
+		message, err := m.Bot.EditMessageReplyMarkup(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2851,10 +2857,10 @@ func TestBot_StopPoll(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedPoll)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		poll, err := m.Bot.StopPoll(nil)
+		poll, err := m.Bot.StopPoll(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedPoll, poll)
 	})
@@ -2864,7 +2870,7 @@ func TestBot_StopPoll(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		poll, err := m.Bot.StopPoll(nil)
+		poll, err := m.Bot.StopPoll(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, poll)
 	})
@@ -2880,10 +2886,10 @@ func TestBot_DeleteMessage(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteMessage(nil)
+		err := m.Bot.DeleteMessage(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2892,7 +2898,7 @@ func TestBot_DeleteMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteMessage(nil)
+		err := m.Bot.DeleteMessage(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2907,10 +2913,10 @@ func TestBot_DeleteMessages(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteMessages(nil)
+		err := m.Bot.DeleteMessages(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -2919,7 +2925,7 @@ func TestBot_DeleteMessages(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteMessages(nil)
+		err := m.Bot.DeleteMessages(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -2935,10 +2941,10 @@ func TestBot_SendSticker(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendSticker(nil)
+		message, err := m.Bot.SendSticker(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -2948,7 +2954,7 @@ func TestBot_SendSticker(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendSticker(nil)
+		message, err := m.Bot.SendSticker(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -2968,10 +2974,10 @@ func TestBot_GetStickerSet(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedStickerSet)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		stickerSet, err := m.Bot.GetStickerSet(nil)
+		stickerSet, err := m.Bot.GetStickerSet(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedStickerSet, stickerSet)
 	})
@@ -2981,7 +2987,7 @@ func TestBot_GetStickerSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		stickerSet, err := m.Bot.GetStickerSet(nil)
+		stickerSet, err := m.Bot.GetStickerSet(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, stickerSet)
 	})
@@ -3001,10 +3007,10 @@ func TestBot_GetCustomEmojiStickers(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedStickers)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		stickers, err := m.Bot.GetCustomEmojiStickers(nil)
+		stickers, err := m.Bot.GetCustomEmojiStickers(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedStickers, stickers)
 	})
@@ -3014,7 +3020,7 @@ func TestBot_GetCustomEmojiStickers(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		stickers, err := m.Bot.GetCustomEmojiStickers(nil)
+		stickers, err := m.Bot.GetCustomEmojiStickers(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, stickers)
 	})
@@ -3034,10 +3040,10 @@ func TestBot_UploadStickerFile(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedFile)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		file, err := m.Bot.UploadStickerFile(nil)
+		file, err := m.Bot.UploadStickerFile(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedFile, file)
 	})
@@ -3047,7 +3053,7 @@ func TestBot_UploadStickerFile(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		file, err := m.Bot.UploadStickerFile(nil)
+		file, err := m.Bot.UploadStickerFile(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, file)
 	})
@@ -3063,10 +3069,10 @@ func TestBot_CreateNewStickerSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.CreateNewStickerSet(nil)
+		err := m.Bot.CreateNewStickerSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3075,7 +3081,7 @@ func TestBot_CreateNewStickerSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.CreateNewStickerSet(nil)
+		err := m.Bot.CreateNewStickerSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3090,10 +3096,10 @@ func TestBot_AddStickerToSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.AddStickerToSet(nil)
+		err := m.Bot.AddStickerToSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3102,7 +3108,7 @@ func TestBot_AddStickerToSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.AddStickerToSet(nil)
+		err := m.Bot.AddStickerToSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3117,10 +3123,10 @@ func TestBot_SetStickerPositionInSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetStickerPositionInSet(nil)
+		err := m.Bot.SetStickerPositionInSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3129,7 +3135,7 @@ func TestBot_SetStickerPositionInSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetStickerPositionInSet(nil)
+		err := m.Bot.SetStickerPositionInSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3144,10 +3150,10 @@ func TestBot_DeleteStickerFromSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteStickerFromSet(nil)
+		err := m.Bot.DeleteStickerFromSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3156,7 +3162,7 @@ func TestBot_DeleteStickerFromSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteStickerFromSet(nil)
+		err := m.Bot.DeleteStickerFromSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3171,10 +3177,10 @@ func TestBot_ReplaceStickerInSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.ReplaceStickerInSet(nil)
+		err := m.Bot.ReplaceStickerInSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3183,7 +3189,7 @@ func TestBot_ReplaceStickerInSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.ReplaceStickerInSet(nil)
+		err := m.Bot.ReplaceStickerInSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3198,10 +3204,10 @@ func TestBot_SetStickerEmojiList(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetStickerEmojiList(nil)
+		err := m.Bot.SetStickerEmojiList(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3210,7 +3216,7 @@ func TestBot_SetStickerEmojiList(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetStickerEmojiList(nil)
+		err := m.Bot.SetStickerEmojiList(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3225,10 +3231,10 @@ func TestBot_SetStickerKeywords(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetStickerKeywords(nil)
+		err := m.Bot.SetStickerKeywords(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3237,7 +3243,7 @@ func TestBot_SetStickerKeywords(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetStickerKeywords(nil)
+		err := m.Bot.SetStickerKeywords(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3252,10 +3258,10 @@ func TestBot_SetStickerMaskPosition(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetStickerMaskPosition(nil)
+		err := m.Bot.SetStickerMaskPosition(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3264,7 +3270,7 @@ func TestBot_SetStickerMaskPosition(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetStickerMaskPosition(nil)
+		err := m.Bot.SetStickerMaskPosition(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3279,10 +3285,10 @@ func TestBot_SetStickerSetTitle(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetStickerSetTitle(nil)
+		err := m.Bot.SetStickerSetTitle(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3291,7 +3297,7 @@ func TestBot_SetStickerSetTitle(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetStickerSetTitle(nil)
+		err := m.Bot.SetStickerSetTitle(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3306,10 +3312,10 @@ func TestBot_SetStickerSetThumbnail(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetStickerSetThumbnail(nil)
+		err := m.Bot.SetStickerSetThumbnail(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3318,7 +3324,7 @@ func TestBot_SetStickerSetThumbnail(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetStickerSetThumbnail(nil)
+		err := m.Bot.SetStickerSetThumbnail(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3333,10 +3339,10 @@ func TestBot_SetCustomEmojiStickerSetThumbnail(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetCustomEmojiStickerSetThumbnail(nil)
+		err := m.Bot.SetCustomEmojiStickerSetThumbnail(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3345,7 +3351,7 @@ func TestBot_SetCustomEmojiStickerSetThumbnail(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetCustomEmojiStickerSetThumbnail(nil)
+		err := m.Bot.SetCustomEmojiStickerSetThumbnail(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3360,10 +3366,10 @@ func TestBot_DeleteStickerSet(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.DeleteStickerSet(nil)
+		err := m.Bot.DeleteStickerSet(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3372,7 +3378,7 @@ func TestBot_DeleteStickerSet(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.DeleteStickerSet(nil)
+		err := m.Bot.DeleteStickerSet(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3391,10 +3397,10 @@ func TestBot_GetAvailableGifts(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedGifts)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		gifts, err := m.Bot.GetAvailableGifts()
+		gifts, err := m.Bot.GetAvailableGifts(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, expectedGifts, gifts)
 	})
@@ -3404,7 +3410,7 @@ func TestBot_GetAvailableGifts(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		gifts, err := m.Bot.GetAvailableGifts()
+		gifts, err := m.Bot.GetAvailableGifts(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, gifts)
 	})
@@ -3420,10 +3426,10 @@ func TestBot_SendGift(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SendGift(nil)
+		err := m.Bot.SendGift(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3432,7 +3438,7 @@ func TestBot_SendGift(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SendGift(nil)
+		err := m.Bot.SendGift(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3447,10 +3453,10 @@ func TestBot_VerifyUser(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.VerifyUser(nil)
+		err := m.Bot.VerifyUser(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3459,7 +3465,7 @@ func TestBot_VerifyUser(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.VerifyUser(nil)
+		err := m.Bot.VerifyUser(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3474,10 +3480,10 @@ func TestBot_VerifyChat(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.VerifyChat(nil)
+		err := m.Bot.VerifyChat(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3486,7 +3492,7 @@ func TestBot_VerifyChat(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.VerifyChat(nil)
+		err := m.Bot.VerifyChat(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3501,10 +3507,10 @@ func TestBot_RemoveUserVerification(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.RemoveUserVerification(nil)
+		err := m.Bot.RemoveUserVerification(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3513,7 +3519,7 @@ func TestBot_RemoveUserVerification(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.RemoveUserVerification(nil)
+		err := m.Bot.RemoveUserVerification(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3528,10 +3534,10 @@ func TestBot_RemoveChatVerification(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.RemoveChatVerification(nil)
+		err := m.Bot.RemoveChatVerification(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3540,7 +3546,7 @@ func TestBot_RemoveChatVerification(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.RemoveChatVerification(nil)
+		err := m.Bot.RemoveChatVerification(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3555,10 +3561,10 @@ func TestBot_AnswerInlineQuery(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.AnswerInlineQuery(nil)
+		err := m.Bot.AnswerInlineQuery(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3567,7 +3573,7 @@ func TestBot_AnswerInlineQuery(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.AnswerInlineQuery(nil)
+		err := m.Bot.AnswerInlineQuery(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3586,10 +3592,10 @@ func TestBot_AnswerWebAppQuery(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedSentWebAppMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		sentWebAppMessage, err := m.Bot.AnswerWebAppQuery(nil)
+		sentWebAppMessage, err := m.Bot.AnswerWebAppQuery(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedSentWebAppMessage, sentWebAppMessage)
 	})
@@ -3599,7 +3605,7 @@ func TestBot_AnswerWebAppQuery(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		sentWebAppMessage, err := m.Bot.AnswerWebAppQuery(nil)
+		sentWebAppMessage, err := m.Bot.AnswerWebAppQuery(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, sentWebAppMessage)
 	})
@@ -3619,10 +3625,10 @@ func TestBot_SavePreparedInlineMessage(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedPreparedInlineMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		preparedInlineMessage, err := m.Bot.SavePreparedInlineMessage(nil)
+		preparedInlineMessage, err := m.Bot.SavePreparedInlineMessage(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedPreparedInlineMessage, preparedInlineMessage)
 	})
@@ -3632,7 +3638,7 @@ func TestBot_SavePreparedInlineMessage(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		preparedInlineMessage, err := m.Bot.SavePreparedInlineMessage(nil)
+		preparedInlineMessage, err := m.Bot.SavePreparedInlineMessage(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, preparedInlineMessage)
 	})
@@ -3649,10 +3655,10 @@ func TestBot_SendInvoice(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendInvoice(nil)
+		message, err := m.Bot.SendInvoice(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -3662,7 +3668,7 @@ func TestBot_SendInvoice(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendInvoice(nil)
+		message, err := m.Bot.SendInvoice(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -3680,10 +3686,10 @@ func TestBot_CreateInvoiceLink(t *testing.T) {
 		expectedInvoiceLink := "InvoiceLink"
 		resp := telegoResponse(t, expectedInvoiceLink)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		invoiceLink, err := m.Bot.CreateInvoiceLink(nil)
+		invoiceLink, err := m.Bot.CreateInvoiceLink(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, &expectedInvoiceLink, invoiceLink)
 	})
@@ -3693,7 +3699,7 @@ func TestBot_CreateInvoiceLink(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		invoiceLink, err := m.Bot.CreateInvoiceLink(nil)
+		invoiceLink, err := m.Bot.CreateInvoiceLink(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, invoiceLink)
 	})
@@ -3709,10 +3715,10 @@ func TestBot_AnswerShippingQuery(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.AnswerShippingQuery(nil)
+		err := m.Bot.AnswerShippingQuery(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3721,7 +3727,7 @@ func TestBot_AnswerShippingQuery(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.AnswerShippingQuery(nil)
+		err := m.Bot.AnswerShippingQuery(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3736,10 +3742,10 @@ func TestBot_AnswerPreCheckoutQuery(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.AnswerPreCheckoutQuery(nil)
+		err := m.Bot.AnswerPreCheckoutQuery(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3748,7 +3754,7 @@ func TestBot_AnswerPreCheckoutQuery(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.AnswerPreCheckoutQuery(nil)
+		err := m.Bot.AnswerPreCheckoutQuery(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3769,10 +3775,10 @@ func TestBot_GetStarTransactions(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedStarTransactions)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		starTransactions, err := m.Bot.GetStarTransactions(nil)
+		starTransactions, err := m.Bot.GetStarTransactions(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedStarTransactions, starTransactions)
 	})
@@ -3782,7 +3788,7 @@ func TestBot_GetStarTransactions(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		starTransactions, err := m.Bot.GetStarTransactions(nil)
+		starTransactions, err := m.Bot.GetStarTransactions(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, starTransactions)
 	})
@@ -3798,10 +3804,10 @@ func TestBot_RefundStarPayment(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.RefundStarPayment(nil)
+		err := m.Bot.RefundStarPayment(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3810,7 +3816,7 @@ func TestBot_RefundStarPayment(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.RefundStarPayment(nil)
+		err := m.Bot.RefundStarPayment(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3825,10 +3831,10 @@ func TestBot_EditUserStarSubscription(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.EditUserStarSubscription(nil)
+		err := m.Bot.EditUserStarSubscription(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3837,7 +3843,7 @@ func TestBot_EditUserStarSubscription(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.EditUserStarSubscription(nil)
+		err := m.Bot.EditUserStarSubscription(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3852,10 +3858,10 @@ func TestBot_SetPassportDataErrors(t *testing.T) {
 			Return(data, nil)
 
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(emptyResp, nil)
 
-		err := m.Bot.SetPassportDataErrors(nil)
+		err := m.Bot.SetPassportDataErrors(context.Background(), nil)
 		require.NoError(t, err)
 	})
 
@@ -3864,7 +3870,7 @@ func TestBot_SetPassportDataErrors(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		err := m.Bot.SetPassportDataErrors(nil)
+		err := m.Bot.SetPassportDataErrors(context.Background(), nil)
 		require.Error(t, err)
 	})
 }
@@ -3880,10 +3886,10 @@ func TestBot_SendGame(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SendGame(nil)
+		message, err := m.Bot.SendGame(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -3893,7 +3899,7 @@ func TestBot_SendGame(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SendGame(nil)
+		message, err := m.Bot.SendGame(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -3910,10 +3916,10 @@ func TestBot_SetGameScore(t *testing.T) {
 
 		resp := telegoResponse(t, expectedMessage)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		message, err := m.Bot.SetGameScore(nil)
+		message, err := m.Bot.SetGameScore(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedMessage, message)
 	})
@@ -3923,7 +3929,7 @@ func TestBot_SetGameScore(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		message, err := m.Bot.SetGameScore(nil)
+		message, err := m.Bot.SetGameScore(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, message)
 	})
@@ -3944,10 +3950,10 @@ func TestBot_GetGameHighScores(t *testing.T) {
 		}
 		resp := telegoResponse(t, expectedGameHighScores)
 		m.MockAPICaller.EXPECT().
-			Call(gomock.Any(), gomock.Any()).
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		gameHighScores, err := m.Bot.GetGameHighScores(nil)
+		gameHighScores, err := m.Bot.GetGameHighScores(context.Background(), nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedGameHighScores, gameHighScores)
 	})
@@ -3957,7 +3963,7 @@ func TestBot_GetGameHighScores(t *testing.T) {
 			JSONRequest(gomock.Any()).
 			Return(nil, errTest)
 
-		gameHighScores, err := m.Bot.GetGameHighScores(nil)
+		gameHighScores, err := m.Bot.GetGameHighScores(context.Background(), nil)
 		require.Error(t, err)
 		assert.Nil(t, gameHighScores)
 	})
@@ -0,0 +1,133 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mymmrac/telego/internal/atomicfile"
+	"github.com/mymmrac/telego/internal/watermark"
+)
+
+// OffsetStore represents storage of long polling offset (ID of the first not yet processed update)
+type OffsetStore interface {
+	// Load returns stored offset, zero if there is no offset stored
+	Load(ctx context.Context) (int, error)
+
+	// Save stores offset
+	Save(ctx context.Context, offset int) error
+}
+
+// MemoryOffsetStore in-memory implementation of [OffsetStore], useful for restarting long polling in the same process
+// Note: Zero value is ready to use
+type MemoryOffsetStore struct {
+	lock   sync.RWMutex
+	offset int
+}
+
+// Load returns offset from memory
+func (s *MemoryOffsetStore) Load(_ context.Context) (int, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.offset, nil
+}
+
+// Save stores offset in memory
+func (s *MemoryOffsetStore) Save(_ context.Context, offset int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.offset = offset
+	return nil
+}
+
+// FileOffsetStore file implementation of [OffsetStore], offset is stored as a decimal number
+type FileOffsetStore struct {
+	// Path - Path to the offset file, its directory must exist
+	Path string
+}
+
+// Load reads offset from file
+func (s *FileOffsetStore) Load(_ context.Context) (int, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("read offset: %w", err)
+	}
+
+	offset, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("parse offset: %w", err)
+	}
+
+	return offset, nil
+}
+
+// Save atomically writes offset to file
+func (s *FileOffsetStore) Save(_ context.Context, offset int) error {
+	if err := atomicfile.WriteFile(s.Path, []byte(strconv.Itoa(offset))); err != nil {
+		return fmt.Errorf("write offset: %w", err)
+	}
+	return nil
+}
+
+// offsetTracker tracks updates delivered by long polling and commits offset once all updates before it are processed
+type offsetTracker struct {
+	store OffsetStore
+
+	lock    sync.Mutex
+	offset  int
+	updates watermark.Tracker
+	changed chan struct{}
+}
+
+// newOffsetTracker creates offset tracker starting from stored offset
+func newOffsetTracker(ctx context.Context, store OffsetStore) (*offsetTracker, error) {
+	offset, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load offset: %w", err)
+	}
+
+	return &offsetTracker{
+		store:   store,
+		offset:  offset,
+		changed: make(chan struct{}),
+	}, nil
+}
+
+// committed returns committed offset and chan that is closed once committed offset changes
+func (t *offsetTracker) committed() (int, <-chan struct{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.offset, t.changed
+}
+
+// deliver registers update as delivered, but not processed yet
+func (t *offsetTracker) deliver(updateID int) {
+	t.updates.Add(updateID)
+}
+
+// markDone marks update as processed and saves new offset if it changed, returns true if offset was saved
+func (t *offsetTracker) markDone(ctx context.Context, updateID int) (bool, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	last, ok := t.updates.Done(updateID)
+	if !ok || last+1 == t.offset {
+		return false, nil
+	}
+
+	t.offset = last + 1
+	close(t.changed)
+	t.changed = make(chan struct{})
+
+	if err := t.store.Save(ctx, t.offset); err != nil {
+		return false, fmt.Errorf("save offset: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,116 @@
+package telego
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryOffsetStore(t *testing.T) {
+	ctx := context.Background()
+	store := &MemoryOffsetStore{}
+
+	offset, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, offset)
+
+	require.NoError(t, store.Save(ctx, 42))
+
+	offset, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 42, offset)
+}
+
+func TestFileOffsetStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		store := &FileOffsetStore{Path: filepath.Join(t.TempDir(), "offset")}
+
+		offset, err := store.Load(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, offset)
+
+		require.NoError(t, store.Save(ctx, 42))
+		require.NoError(t, store.Save(ctx, 43))
+
+		offset, err = store.Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 43, offset)
+
+		entries, err := os.ReadDir(filepath.Dir(store.Path))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("error_parse", func(t *testing.T) {
+		store := &FileOffsetStore{Path: filepath.Join(t.TempDir(), "offset")}
+		require.NoError(t, os.WriteFile(store.Path, []byte("test"), 0o600))
+
+		_, err := store.Load(ctx)
+		require.Error(t, err)
+	})
+
+	t.Run("error_save", func(t *testing.T) {
+		store := &FileOffsetStore{Path: filepath.Join(t.TempDir(), "not-exist", "offset")}
+
+		err := store.Save(ctx, 1)
+		require.Error(t, err)
+	})
+}
+
+func TestOffsetTracker(t *testing.T) {
+	ctx := context.Background()
+	store := &MemoryOffsetStore{}
+	require.NoError(t, store.Save(ctx, 10))
+
+	tracker, err := newOffsetTracker(ctx, store)
+	require.NoError(t, err)
+
+	offset, changed := tracker.committed()
+	assert.Equal(t, 10, offset)
+
+	tracker.deliver(10)
+	tracker.deliver(11)
+	tracker.deliver(13)
+
+	saved, err := tracker.markDone(ctx, 11)
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	saved, err = tracker.markDone(ctx, 10)
+	require.NoError(t, err)
+	assert.True(t, saved)
+	assert.Equal(t, 12, mustLoad(t, store))
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("committed offset change not signaled")
+	}
+
+	saved, err = tracker.markDone(ctx, 10)
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	saved, err = tracker.markDone(ctx, 12)
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	saved, err = tracker.markDone(ctx, 13)
+	require.NoError(t, err)
+	assert.True(t, saved)
+	assert.Equal(t, 14, mustLoad(t, store))
+}
+
+func mustLoad(t *testing.T, store OffsetStore) int {
+	t.Helper()
+
+	offset, err := store.Load(context.Background())
+	require.NoError(t, err)
+	return offset
+}
@@ -0,0 +1,200 @@
+package telego
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// StructuredLogger represents logger with levels and attributes used to log debug, info, warning and error
+// information
+type StructuredLogger interface {
+	// Enabled reports whether logger emits log records at the given level
+	Enabled(ctx context.Context, level slog.Level) bool
+
+	// Log emits log record with the given level, message and attributes
+	Log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr)
+}
+
+// Log attribute keys used by Telego
+const (
+	LogKeyMethod   = "method"
+	LogKeyURL      = "url"
+	LogKeyData     = "data"
+	LogKeyResponse = "response"
+	LogKeyError    = "error"
+	LogKeyChatID   = "chat_id"
+	LogKeyUpdateID = "update_id"
+	LogKeyDuration = "duration"
+	LogKeyFileID   = "file_id"
+	LogKeyOffset   = "offset"
+)
+
+// slogLogger adapter of [slog.Logger] to [StructuredLogger]
+type slogLogger struct {
+	log *slog.Logger
+}
+
+// NewSlogLogger creates [StructuredLogger] that logs using provided [slog.Logger]
+func NewSlogLogger(log *slog.Logger) StructuredLogger {
+	return slogLogger{log: log}
+}
+
+// Enabled reports whether slog logger is enabled for level
+func (s slogLogger) Enabled(ctx context.Context, level slog.Level) bool {
+	return s.log.Enabled(ctx, level)
+}
+
+// Log logs using slog logger
+func (s slogLogger) Log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	s.log.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// loggerAdapter adapter of [Logger] to [StructuredLogger], debug and info records are logged as debug, warning and
+// error records are logged as error
+type loggerAdapter struct {
+	log Logger
+}
+
+// Enabled always returns true, since [Logger] has no way to report enabled levels
+func (l loggerAdapter) Enabled(_ context.Context, _ slog.Level) bool {
+	return true
+}
+
+// Log logs message with attributes formatted as key=value pairs
+func (l loggerAdapter) Log(_ context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	text := formatLogRecord(msg, attrs)
+	if level >= slog.LevelWarn {
+		l.log.Errorf("%s", text)
+	} else {
+		l.log.Debugf("%s", text)
+	}
+}
+
+// structuredLoggerAdapter adapter of [StructuredLogger] to [Logger]
+type structuredLoggerAdapter struct {
+	log StructuredLogger
+}
+
+// Debugf logs debug message
+func (s structuredLoggerAdapter) Debugf(format string, args ...any) {
+	ctx := context.Background()
+	if s.log.Enabled(ctx, slog.LevelDebug) {
+		s.log.Log(ctx, slog.LevelDebug, fmt.Sprintf(format, args...))
+	}
+}
+
+// Errorf logs error message
+func (s structuredLoggerAdapter) Errorf(format string, args ...any) {
+	ctx := context.Background()
+	if s.log.Enabled(ctx, slog.LevelError) {
+		s.log.Log(ctx, slog.LevelError, fmt.Sprintf(format, args...))
+	}
+}
+
+// redactingLogger [StructuredLogger] that replaces sensitive data (like bot token) in messages and attributes
+type redactingLogger struct {
+	log      StructuredLogger
+	replacer *strings.Replacer
+}
+
+// Enabled reports whether underlying logger is enabled for level
+func (r redactingLogger) Enabled(ctx context.Context, level slog.Level) bool {
+	return r.log.Enabled(ctx, level)
+}
+
+// Log logs redacted message and attributes
+func (r redactingLogger) Log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	redactedAttrs := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redactedAttrs[i] = redactAttr(r.replacer, attr)
+	}
+	r.log.Log(ctx, level, r.replacer.Replace(msg), redactedAttrs...)
+}
+
+// RedactTokenAttr returns attribute filter that replaces bot token with [DefaultLoggerTokenReplacement] in string
+// values, errors and stringers, can be used as [slog.HandlerOptions.ReplaceAttr]
+func RedactTokenAttr(token string) func(groups []string, attr slog.Attr) slog.Attr {
+	replacer := defaultReplacer(token)
+	return func(_ []string, attr slog.Attr) slog.Attr {
+		return redactAttr(replacer, attr)
+	}
+}
+
+// redactAttr replaces sensitive data in attribute value
+func redactAttr(replacer *strings.Replacer, attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, replacer.Replace(value.String()))
+	case slog.KindGroup:
+		groupAttrs := value.Group()
+		redactedAttrs := make([]slog.Attr, len(groupAttrs))
+		for i, groupAttr := range groupAttrs {
+			redactedAttrs[i] = redactAttr(replacer, groupAttr)
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(redactedAttrs...)}
+	case slog.KindAny:
+		switch v := value.Any().(type) {
+		case error:
+			return slog.String(attr.Key, replacer.Replace(v.Error()))
+		case fmt.Stringer:
+			return slog.String(attr.Key, replacer.Replace(v.String()))
+		}
+	default:
+		// Other kinds can't contain sensitive data
+	}
+
+	return slog.Attr{Key: attr.Key, Value: value}
+}
+
+// formatLogRecord formats message with attributes as key=value pairs
+func formatLogRecord(msg string, attrs []slog.Attr) string {
+	if len(attrs) == 0 {
+		return msg
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(msg)
+	for _, attr := range attrs {
+		writeLogAttr(&sb, "", attr)
+	}
+	return sb.String()
+}
+
+// writeLogAttr writes attribute as key=value pair, group attributes are prefixed with group key
+func writeLogAttr(sb *strings.Builder, prefix string, attr slog.Attr) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		groupPrefix := prefix + attr.Key + "."
+		for _, groupAttr := range value.Group() {
+			writeLogAttr(sb, groupPrefix, groupAttr)
+		}
+		return
+	}
+
+	sb.WriteByte(' ')
+	sb.WriteString(prefix + attr.Key)
+	sb.WriteByte('=')
+
+	text := value.String()
+	if value.Kind() == slog.KindString && (text == "" || strings.ContainsAny(text, " =\"\n")) {
+		text = fmt.Sprintf("%q", text)
+	}
+	sb.WriteString(text)
+}
+
+// logEnabled reports whether bot logger is enabled for level
+func (b *Bot) logEnabled(ctx context.Context, level slog.Level) bool {
+	return b.StructuredLogger().Enabled(ctx, level)
+}
+
+// logAttrs logs message with attributes using bot logger if it's enabled for level
+func (b *Bot) logAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	log := b.StructuredLogger()
+	if log.Enabled(ctx, level) {
+		log.Log(ctx, level, msg, attrs...)
+	}
+}
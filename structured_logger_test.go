@@ -0,0 +1,198 @@
+package telego
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogRecord struct {
+	level slog.Level
+	msg   string
+	attrs []slog.Attr
+}
+
+type testStructuredLogger struct {
+	level   slog.Level
+	records []testLogRecord
+}
+
+func (l *testStructuredLogger) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= l.level
+}
+
+func (l *testStructuredLogger) Log(_ context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	l.records = append(l.records, testLogRecord{level: level, msg: msg, attrs: attrs})
+}
+
+type testFormatLogger struct {
+	debug []string
+	error []string
+}
+
+func (l *testFormatLogger) Debugf(format string, args ...any) {
+	l.debug = append(l.debug, format)
+	_ = args
+}
+
+func (l *testFormatLogger) Errorf(format string, args ...any) {
+	l.error = append(l.error, args[0].(string)) //nolint:forcetypeassert
+	_ = format
+}
+
+func TestNewSlogLogger(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log := NewSlogLogger(slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	ctx := context.Background()
+
+	assert.False(t, log.Enabled(ctx, slog.LevelDebug))
+	assert.True(t, log.Enabled(ctx, slog.LevelWarn))
+
+	log.Log(ctx, slog.LevelWarn, "test", slog.String(LogKeyMethod, "getMe"))
+	assert.Contains(t, buffer.String(), "level=WARN")
+	assert.Contains(t, buffer.String(), "msg=test")
+	assert.Contains(t, buffer.String(), "method=getMe")
+}
+
+func Test_loggerAdapter(t *testing.T) {
+	formatLog := &testFormatLogger{}
+	log := loggerAdapter{log: formatLog}
+	ctx := context.Background()
+
+	assert.True(t, log.Enabled(ctx, slog.LevelDebug))
+
+	log.Log(ctx, slog.LevelInfo, "info", slog.Int(LogKeyUpdateID, 1))
+	log.Log(ctx, slog.LevelWarn, "warn", slog.String(LogKeyData, "a b"))
+	log.Log(ctx, slog.LevelError, "error", slog.Group("group", slog.Bool("ok", true)))
+
+	assert.Equal(t, []string{"%s"}, formatLog.debug)
+	assert.Equal(t, []string{`warn data="a b"`, "error group.ok=true"}, formatLog.error)
+}
+
+func Test_structuredLoggerAdapter(t *testing.T) {
+	structuredLog := &testStructuredLogger{level: slog.LevelError}
+	log := structuredLoggerAdapter{log: structuredLog}
+
+	log.Debugf("debug %d", 1)
+	log.Errorf("error %d", 2)
+
+	require.Len(t, structuredLog.records, 1)
+	assert.Equal(t, slog.LevelError, structuredLog.records[0].level)
+	assert.Equal(t, "error 2", structuredLog.records[0].msg)
+
+	structuredLog.level = slog.LevelDebug
+	log.Debugf("debug %d", 1)
+	require.Len(t, structuredLog.records, 2)
+	assert.Equal(t, "debug 1", structuredLog.records[1].msg)
+}
+
+func Test_redactingLogger(t *testing.T) {
+	structuredLog := &testStructuredLogger{}
+	log := redactingLogger{log: structuredLog, replacer: defaultReplacer(token)}
+	ctx := context.Background()
+
+	assert.True(t, log.Enabled(ctx, slog.LevelInfo))
+
+	log.Log(ctx, slog.LevelInfo, "call "+token,
+		slog.String(LogKeyURL, "https://api.telegram.org/bot"+token+"/getMe"),
+		slog.Any(LogKeyError, errors.New("error "+token)),
+		slog.Group("group", slog.String("value", token)),
+		slog.Int(LogKeyUpdateID, 1),
+		slog.Duration(LogKeyDuration, time.Second),
+	)
+
+	require.Len(t, structuredLog.records, 1)
+	record := structuredLog.records[0]
+	assert.Equal(t, "call "+DefaultLoggerTokenReplacement, record.msg)
+
+	text := formatLogRecord(record.msg, record.attrs)
+	assert.NotContains(t, text, token)
+	assert.Contains(t, text, "url=https://api.telegram.org/bot"+DefaultLoggerTokenReplacement+"/getMe")
+	assert.Contains(t, text, `error="error `+DefaultLoggerTokenReplacement+`"`)
+	assert.Contains(t, text, "group.value="+DefaultLoggerTokenReplacement)
+	assert.Contains(t, text, "update_id=1")
+	assert.Contains(t, text, "duration=1s")
+}
+
+func TestRedactTokenAttr(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{ReplaceAttr: RedactTokenAttr(token)}))
+
+	log.Info("test", slog.String(LogKeyURL, "bot"+token), slog.Any(LogKeyChatID, ChatID{Username: token}))
+	assert.NotContains(t, buffer.String(), token)
+	assert.Contains(t, buffer.String(), "url=bot"+DefaultLoggerTokenReplacement)
+	assert.Contains(t, buffer.String(), "chat_id="+DefaultLoggerTokenReplacement)
+}
+
+func Test_logger_Log(t *testing.T) {
+	l, b := testLogger()
+	ctx := context.Background()
+
+	l.Log(ctx, slog.LevelError, "disabled")
+	assert.Equal(t, "", b.String())
+
+	l.PrintErrors = true
+	assert.False(t, l.Enabled(ctx, slog.LevelInfo))
+	assert.True(t, l.Enabled(ctx, slog.LevelWarn))
+
+	l.Log(ctx, slog.LevelWarn, "warn", slog.String(LogKeyMethod, "getMe"))
+	assert.Contains(t, b.String(), "WARN")
+	assert.Contains(t, b.String(), "warn method=getMe\n")
+
+	l.Log(ctx, slog.LevelError, "error")
+	assert.Contains(t, b.String(), "ERROR")
+
+	l.Log(ctx, slog.LevelInfo, "info")
+	assert.NotContains(t, b.String(), "info")
+
+	l.DebugMode = true
+	l.Log(ctx, slog.LevelInfo, "info")
+	assert.Contains(t, b.String(), "INFO")
+	l.Log(ctx, slog.LevelDebug, "debug")
+	assert.Contains(t, b.String(), "DEBUG")
+}
+
+func TestBot_StructuredLogger(t *testing.T) {
+	bot := &Bot{log: newDefaultLogger(token)}
+	assert.Equal(t, bot.log, bot.StructuredLogger())
+
+	formatLog := &testFormatLogger{}
+	bot.log = formatLog
+	assert.Equal(t, loggerAdapter{log: formatLog}, bot.StructuredLogger())
+
+	structuredLog := &testStructuredLogger{}
+	bot.logStruct = structuredLog
+	assert.Equal(t, structuredLog, bot.StructuredLogger())
+}
+
+func TestBot_logAttrs(t *testing.T) {
+	structuredLog := &testStructuredLogger{level: slog.LevelInfo}
+	bot := &Bot{logStruct: structuredLog}
+	ctx := context.Background()
+
+	bot.logAttrs(ctx, slog.LevelDebug, "debug")
+	bot.logAttrs(ctx, slog.LevelInfo, "info", slog.Int(LogKeyUpdateID, 1))
+
+	require.Len(t, structuredLog.records, 1)
+	assert.Equal(t, "info", structuredLog.records[0].msg)
+	assert.True(t, bot.logEnabled(ctx, slog.LevelWarn))
+	assert.False(t, bot.logEnabled(ctx, slog.LevelDebug))
+}
+
+func Test_formatLogRecord(t *testing.T) {
+	assert.Equal(t, "msg", formatLogRecord("msg", nil))
+	assert.Equal(t, `msg a=1 b="" c="x=y" d.e=true`, formatLogRecord("msg", []slog.Attr{
+		slog.Int("a", 1),
+		slog.String("b", ""),
+		slog.String("c", "x=y"),
+		slog.Group("d", slog.Bool("e", true)),
+	}))
+	assert.True(t, strings.HasPrefix(formatLogRecord("msg", []slog.Attr{slog.String("a", "b")}), "msg "))
+}
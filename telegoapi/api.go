@@ -2,6 +2,7 @@ package telegoapi
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 
@@ -63,11 +64,39 @@ type ResponseParameters struct {
 type RequestData struct {
 	ContentType string
 	Buffer      *bytes.Buffer
+
+	// BodyStream - Optional request body that is streamed to the server instead of Buffer, can be read only once
+	BodyStream io.Reader
+
+	// BodySize - Size of BodyStream in bytes, negative if unknown (chunked transfer encoding is used in this case)
+	BodySize int64
+
+	// ChatID - Optional chat ID (or username) the request is sent to, set by request constructors so callers (like
+	// [RateLimitCaller]) don't have to parse the body, which is not possible for streamed requests
+	ChatID string
+}
+
+// Streaming returns true if request body is streamed
+func (d *RequestData) Streaming() bool {
+	return d != nil && d.BodyStream != nil
+}
+
+// CloseBody closes body stream if it's closable, should be called once request is done to release resources
+// associated with the stream (for example, goroutine that writes body), it's safe to call multiple times
+func (d *RequestData) CloseBody() {
+	if !d.Streaming() {
+		return
+	}
+
+	if closer, ok := d.BodyStream.(io.Closer); ok {
+		_ = closer.Close()
+	}
 }
 
 // Caller represents way to call API with request
+// Note: Implementations should abort the request once the context is canceled or its deadline is exceeded
 type Caller interface {
-	Call(url string, data *RequestData) (*Response, error)
+	Call(ctx context.Context, url string, data *RequestData) (*Response, error)
 }
 
 // NamedReader represents a way to send files (or other data).
@@ -3,10 +3,12 @@
 package telegoapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"math/rand/v2"
 	"net/http"
 	"time"
 
@@ -18,37 +20,80 @@ import (
 // FastHTTPCaller fasthttp implementation of Caller
 type FastHTTPCaller struct {
 	Client *fasthttp.Client
+
+	// DownloadClient - Optional client used for downloads, it should have StreamResponseBody enabled to not read whole
+	// file into memory, Client used if nil
+	DownloadClient *fasthttp.Client
 }
 
 // DefaultFastHTTPCaller is a default fast http caller
 var DefaultFastHTTPCaller = &FastHTTPCaller{
-	Client: &fasthttp.Client{},
+	Client:         &fasthttp.Client{},
+	DownloadClient: &fasthttp.Client{StreamResponseBody: true},
 }
 
 // Call is a fasthttp implementation
-func (a FastHTTPCaller) Call(url string, data *RequestData) (*Response, error) {
-	req := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(req)
+// Note: As fasthttp doesn't support context, the deadline of the context is used as request deadline, and
+// cancellation of the context makes the call return immediately, while the request itself is finished in background
+func (a FastHTTPCaller) Call(ctx context.Context, url string, data *RequestData) (*Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("fasthttp do request: %w", err)
+	}
 
+	req := fasthttp.AcquireRequest()
 	req.SetRequestURI(url)
 	req.Header.SetContentType(data.ContentType)
 	req.Header.SetMethod(fasthttp.MethodPost)
-	req.SetBodyRaw(data.Buffer.Bytes())
+	if data.Streaming() {
+		req.SetBodyStream(data.BodyStream, int(max(data.BodySize, -1)))
+	} else {
+		req.SetBodyRaw(data.Buffer.Bytes())
+	}
 
 	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(resp)
 
-	err := a.Client.Do(req, resp)
-	if err != nil {
-		return nil, fmt.Errorf("fasthttp do request: %w", err)
+	done := make(chan error, 1)
+	go func() {
+		if deadline, ok := ctx.Deadline(); ok {
+			done <- a.Client.DoDeadline(req, resp, deadline)
+		} else {
+			done <- a.Client.Do(req, resp)
+		}
+	}()
+
+	select {
+	case err := <-done:
+		defer fasthttp.ReleaseRequest(req)
+		defer fasthttp.ReleaseResponse(resp)
+
+		if err != nil {
+			return nil, fmt.Errorf("fasthttp do request: %w", err)
+		}
+
+		return parseFastHTTPResponse(resp)
+	case <-ctx.Done():
+		// Closing body stream aborts the upload, so the request is finished sooner
+		data.CloseBody()
+
+		// Request and response can only be released once the request is done
+		go func() {
+			<-done
+			fasthttp.ReleaseRequest(req)
+			fasthttp.ReleaseResponse(resp)
+		}()
+
+		return nil, fmt.Errorf("fasthttp do request: %w", ctx.Err())
 	}
+}
 
+// parseFastHTTPResponse parses fasthttp response into API response
+func parseFastHTTPResponse(resp *fasthttp.Response) (*Response, error) {
 	if statusCode := resp.StatusCode(); statusCode >= fasthttp.StatusInternalServerError {
 		return nil, fmt.Errorf("internal server error: %d", statusCode)
 	}
 
 	apiResp := &Response{}
-	err = json.Unmarshal(resp.Body(), apiResp)
+	err := json.Unmarshal(resp.Body(), apiResp)
 	if err != nil {
 		return nil, fmt.Errorf("decode json: %w", err)
 	}
@@ -67,11 +112,19 @@ var DefaultHTTPCaller = &HTTPCaller{
 }
 
 // Call is a http implementation
-func (h HTTPCaller) Call(url string, data *RequestData) (*Response, error) {
-	req, err := http.NewRequest(http.MethodPost, url, data.Buffer)
+func (h HTTPCaller) Call(ctx context.Context, url string, data *RequestData) (*Response, error) {
+	var reqBody io.Reader = data.Buffer
+	if data.Streaming() {
+		reqBody = data.BodyStream
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("http create request: %w", err)
 	}
+	if data.Streaming() {
+		req.ContentLength = max(data.BodySize, -1)
+	}
 	req.Header.Set(ContentTypeHeader, data.ContentType)
 
 	resp, err := h.Client.Do(req)
@@ -100,34 +153,152 @@ func (h HTTPCaller) Call(url string, data *RequestData) (*Response, error) {
 
 // RetryCaller decorator over Caller that provides reties with exponential backoff
 // Delay = (ExponentBase ^ AttemptNumber) * StartDelay or MaxDelay
+//
+// Failed calls are retried only if [RetryPolicy] allows it, by default [DefaultRetryPolicy] is used, which doesn't
+// retry send-once methods (like sendMessage) after ambiguous failures and honours flood control wait time.
+// Calls with streamed body (see [RequestData.BodyStream]) are never retried, since the body can be read only once.
 type RetryCaller struct {
 	Caller       Caller
 	MaxAttempts  int
 	ExponentBase float64
 	StartDelay   time.Duration
 	MaxDelay     time.Duration
+
+	// Policy - Decides if failed call should be retried, [DefaultRetryPolicy] used if nil
+	Policy RetryPolicy
+
+	// Jitter - Fraction of delay (from 0 to 1) randomly added to each delay, useful to spread retries of
+	// concurrent calls over time
+	Jitter float64
+
+	// MaxElapsed - Total time budget for all attempts including delays, zero means no limit
+	MaxElapsed time.Duration
+
+	// OnAttempt - Optional hook that is called after each attempt
+	OnAttempt func(attempt RetryAttempt)
 }
 
-// ErrMaxRetryAttempts returned when max retry attempts reached
-var ErrMaxRetryAttempts = errors.New("max retry attempts reached")
+var (
+	// ErrMaxRetryAttempts returned when max retry attempts reached
+	ErrMaxRetryAttempts = errors.New("max retry attempts reached")
+
+	// ErrRetryBudgetExceeded returned when next retry can't fit into total time budget
+	ErrRetryBudgetExceeded = errors.New("retry time budget exceeded")
+)
+
+// Call makes calls using provided caller with retries, retries are stopped once context is done
+// Note: If the last attempt returned unsuccessful API response, it will be returned as is without error
+func (r *RetryCaller) Call(ctx context.Context, url string, data *RequestData) (resp *Response, err error) {
+	policy := r.Policy
+	if policy == nil {
+		policy = DefaultRetryPolicy{}
+	}
+
+	start := time.Now()
+	method := methodFromURL(url)
 
-// Call makes calls using provided caller with retries
-func (r *RetryCaller) Call(url string, data *RequestData) (resp *Response, err error) {
 	for i := 0; i < r.MaxAttempts; i++ {
-		resp, err = r.Caller.Call(url, data)
-		if err == nil {
+		resp, err = r.Caller.Call(ctx, url, data)
+
+		attempt := RetryAttempt{
+			Method:   method,
+			Attempt:  i + 1,
+			Response: resp,
+			Err:      err,
+		}
+
+		if err == nil && (resp == nil || resp.Ok) {
+			r.reportAttempt(attempt)
 			return resp, nil
 		}
 
+		if ctx.Err() != nil {
+			r.reportAttempt(attempt)
+			return nil, err
+		}
+
+		retry, delay := policy.Retry(attempt)
+		if !retry || data.Streaming() {
+			r.reportAttempt(attempt)
+
+			if err == nil {
+				return resp, nil
+			}
+			return nil, err
+		}
+
 		if i == r.MaxAttempts-1 {
+			r.reportAttempt(attempt)
 			break
 		}
 
-		delay := time.Duration(math.Pow(r.ExponentBase, float64(i))) * r.StartDelay
-		if delay > r.MaxDelay {
-			delay = r.MaxDelay
+		if delay <= 0 {
+			delay = r.backoffDelay(i)
 		}
-		time.Sleep(delay)
+		delay += r.jitter(delay)
+
+		if r.MaxElapsed > 0 && time.Since(start)+delay > r.MaxElapsed {
+			r.reportAttempt(attempt)
+
+			if err == nil {
+				return resp, nil
+			}
+			return nil, errors.Join(err, ErrRetryBudgetExceeded)
+		}
+
+		attempt.Retry = true
+		attempt.Delay = delay
+		r.reportAttempt(attempt)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if err == nil {
+				return nil, ctx.Err()
+			}
+			return nil, errors.Join(err, ctx.Err())
+		case <-timer.C:
+			// Continue retrying
+		}
+	}
+
+	if err == nil {
+		return resp, nil
 	}
 	return nil, errors.Join(err, ErrMaxRetryAttempts)
 }
+
+// backoffDelay returns exponential backoff delay for attempt
+func (r *RetryCaller) backoffDelay(attempt int) time.Duration {
+	delay := time.Duration(math.Pow(r.ExponentBase, float64(attempt))) * r.StartDelay
+	if delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	return delay
+}
+
+// jitter returns random duration to add to delay
+func (r *RetryCaller) jitter(delay time.Duration) time.Duration {
+	if r.Jitter <= 0 || delay <= 0 {
+		return 0
+	}
+
+	jitter := r.Jitter
+	if jitter > 1 {
+		jitter = 1
+	}
+
+	maxJitter := int64(float64(delay) * jitter)
+	if maxJitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(maxJitter)) //nolint:gosec
+}
+
+// reportAttempt calls attempt hook if set
+func (r *RetryCaller) reportAttempt(attempt RetryAttempt) {
+	if r.OnAttempt != nil {
+		r.OnAttempt(attempt)
+	}
+}
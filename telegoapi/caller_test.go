@@ -2,11 +2,15 @@ package telegoapi
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +21,10 @@ import (
 const (
 	errJSONPath = "/json_err"
 	err500Path  = "/500"
+	slowPath    = "/slow"
+
+	smallTimeout = time.Millisecond * 10
+	slowTimeout  = time.Millisecond * 500
 )
 
 var _ Caller = DefaultFastHTTPCaller
@@ -56,26 +64,55 @@ func TestFastHTTPCaller_Call(t *testing.T) {
 		Buffer:      bytes.NewBufferString("test"),
 	}
 
+	ctx := context.Background()
+
 	t.Run("success", func(t *testing.T) {
-		resp, err := caller.Call("http://localhost", data)
+		resp, err := caller.Call(ctx, "http://localhost", data)
 		require.NoError(t, err)
 		assert.True(t, resp.Ok)
 	})
 
 	t.Run("error_fasthttp_do_request", func(t *testing.T) {
-		resp, err := caller.Call("abc", data)
+		resp, err := caller.Call(ctx, "abc", data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
 
 	t.Run("error_500", func(t *testing.T) {
-		resp, err := caller.Call("http://localhost/500", data)
+		resp, err := caller.Call(ctx, "http://localhost/500", data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
 
 	t.Run("error_json", func(t *testing.T) {
-		resp, err := caller.Call("http://localhost/json_err", data)
+		resp, err := caller.Call(ctx, "http://localhost/json_err", data)
+		require.Error(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("error_context_canceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		resp, err := caller.Call(canceledCtx, "http://localhost", data)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("error_context_canceled_during_request", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		time.AfterFunc(smallTimeout, cancel)
+
+		resp, err := caller.Call(canceledCtx, "http://localhost"+slowPath, data)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("error_context_deadline", func(t *testing.T) {
+		deadlineCtx, cancel := context.WithTimeout(ctx, smallTimeout)
+		defer cancel()
+
+		resp, err := caller.Call(deadlineCtx, "http://localhost"+slowPath, data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
@@ -90,6 +127,9 @@ func (s *fasthttpServer) Handle(ctx *fasthttp.RequestCtx) {
 	assert.Equal(s.t, ContentTypeJSON, string(ctx.Request.Header.ContentType())) //nolint:testifylint
 
 	switch string(ctx.Path()) {
+	case slowPath:
+		time.Sleep(slowTimeout)
+		ctx.SetStatusCode(fasthttp.StatusOK)
 	case err500Path:
 		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
 	case errJSONPath:
@@ -120,35 +160,46 @@ func TestHTTPCaller_Call(t *testing.T) {
 		Buffer:      bytes.NewBufferString("test"),
 	}
 
+	ctx := context.Background()
+
 	t.Run("success", func(t *testing.T) {
-		resp, err := caller.Call(srv.URL, data)
+		resp, err := caller.Call(ctx, srv.URL, data)
 		require.NoError(t, err)
 		assert.True(t, resp.Ok)
 	})
 
 	t.Run("error_http_create_request", func(t *testing.T) {
-		resp, err := caller.Call("\x00", data)
+		resp, err := caller.Call(ctx, "\x00", data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
 
 	t.Run("error_http_do_request", func(t *testing.T) {
-		resp, err := caller.Call("abc", data)
+		resp, err := caller.Call(ctx, "abc", data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
 
 	t.Run("error_500", func(t *testing.T) {
-		resp, err := caller.Call(srv.URL+err500Path, data)
+		resp, err := caller.Call(ctx, srv.URL+err500Path, data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
 
 	t.Run("error_json", func(t *testing.T) {
-		resp, err := caller.Call(srv.URL+errJSONPath, data)
+		resp, err := caller.Call(ctx, srv.URL+errJSONPath, data)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
+
+	t.Run("error_context_canceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		resp, err := caller.Call(canceledCtx, srv.URL, data)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, resp)
+	})
 }
 
 type httpServer struct {
@@ -176,20 +227,33 @@ func (h *httpServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 type testRetryCaller struct {
 	resp     *Response
 	err      error
+	failResp *Response
 	attempts int
 	okAfter  int
 }
 
-func (t *testRetryCaller) Call(_ string, _ *RequestData) (*Response, error) {
+func (t *testRetryCaller) Call(_ context.Context, _ string, _ *RequestData) (*Response, error) {
 	t.attempts++
 	if t.okAfter != 0 && t.attempts > t.okAfter {
 		return t.resp, nil
 	}
+	if t.failResp != nil {
+		return t.failResp, t.err
+	}
 	return t.resp, t.err
 }
 
+type testRetryPolicy struct {
+	delay time.Duration
+}
+
+func (p testRetryPolicy) Retry(_ RetryAttempt) (bool, time.Duration) {
+	return true, p.delay
+}
+
 func TestRetryCaller_Call(t *testing.T) {
 	expectedResp := &Response{Ok: true}
+	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
 		retryCaller := &RetryCaller{
@@ -199,7 +263,7 @@ func TestRetryCaller_Call(t *testing.T) {
 			},
 			MaxAttempts: 1,
 		}
-		resp, err := retryCaller.Call("", nil)
+		resp, err := retryCaller.Call(ctx, "", nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedResp, resp)
 	})
@@ -213,7 +277,7 @@ func TestRetryCaller_Call(t *testing.T) {
 			},
 			MaxAttempts: 3,
 		}
-		resp, err := retryCaller.Call("", nil)
+		resp, err := retryCaller.Call(ctx, "", nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedResp, resp)
 	})
@@ -226,7 +290,7 @@ func TestRetryCaller_Call(t *testing.T) {
 			},
 			MaxAttempts: 2,
 		}
-		resp, err := retryCaller.Call("", nil)
+		resp, err := retryCaller.Call(ctx, "", nil)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
@@ -242,8 +306,255 @@ func TestRetryCaller_Call(t *testing.T) {
 			StartDelay:   10,
 			MaxDelay:     1,
 		}
-		resp, err := retryCaller.Call("", nil)
+		resp, err := retryCaller.Call(ctx, "", nil)
 		require.Error(t, err)
 		assert.Nil(t, resp)
 	})
+
+	t.Run("error_context_canceled", func(t *testing.T) {
+		retryCaller := &RetryCaller{
+			Caller: &testRetryCaller{
+				resp: nil,
+				err:  errors.New("test"),
+			},
+			MaxAttempts: 3,
+			StartDelay:  time.Hour,
+			MaxDelay:    time.Hour,
+		}
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		time.AfterFunc(smallTimeout, cancel)
+
+		resp, err := retryCaller.Call(canceledCtx, "", nil)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("error_send_once", func(t *testing.T) {
+		caller := &testRetryCaller{
+			resp: nil,
+			err:  errors.New("test"),
+		}
+		retryCaller := &RetryCaller{
+			Caller:      caller,
+			MaxAttempts: 3,
+		}
+
+		resp, err := retryCaller.Call(ctx, "https://api.telegram.org/bot1:token/sendMessage", nil)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrMaxRetryAttempts)
+		assert.Nil(t, resp)
+		assert.Equal(t, 1, caller.attempts)
+	})
+
+	t.Run("success_retry_after", func(t *testing.T) {
+		floodResp := &Response{
+			Ok:    false,
+			Error: &Error{ErrorCode: 429, Parameters: &ResponseParameters{RetryAfter: 1}},
+		}
+		caller := &testRetryCaller{
+			resp:     expectedResp,
+			failResp: floodResp,
+			okAfter:  1,
+		}
+
+		var attempts []RetryAttempt
+		retryCaller := &RetryCaller{
+			Caller:      caller,
+			MaxAttempts: 3,
+			Policy:      testRetryPolicy{delay: time.Millisecond},
+			Jitter:      0.5,
+			OnAttempt: func(attempt RetryAttempt) {
+				attempts = append(attempts, attempt)
+			},
+		}
+
+		resp, err := retryCaller.Call(ctx, "https://api.telegram.org/bot1:token/sendMessage", nil)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResp, resp)
+
+		require.Len(t, attempts, 2)
+		assert.Equal(t, "sendMessage", attempts[0].Method)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		assert.Equal(t, floodResp, attempts[0].Response)
+		assert.True(t, attempts[0].Retry)
+		assert.GreaterOrEqual(t, attempts[0].Delay, time.Millisecond)
+		assert.Less(t, attempts[0].Delay, time.Millisecond*3/2)
+		assert.Equal(t, 2, attempts[1].Attempt)
+		assert.False(t, attempts[1].Retry)
+	})
+
+	t.Run("error_retry_after_budget", func(t *testing.T) {
+		floodResp := &Response{
+			Ok:    false,
+			Error: &Error{ErrorCode: 429, Parameters: &ResponseParameters{RetryAfter: 10}},
+		}
+		caller := &testRetryCaller{
+			failResp: floodResp,
+		}
+		retryCaller := &RetryCaller{
+			Caller:      caller,
+			MaxAttempts: 3,
+			MaxElapsed:  time.Second,
+		}
+
+		resp, err := retryCaller.Call(ctx, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, floodResp, resp)
+		assert.Equal(t, 1, caller.attempts)
+	})
+
+	t.Run("error_budget", func(t *testing.T) {
+		retryCaller := &RetryCaller{
+			Caller: &testRetryCaller{
+				resp: nil,
+				err:  errors.New("test"),
+			},
+			MaxAttempts: 3,
+			StartDelay:  time.Hour,
+			MaxDelay:    time.Hour,
+			MaxElapsed:  time.Second,
+		}
+
+		resp, err := retryCaller.Call(ctx, "", nil)
+		require.ErrorIs(t, err, ErrRetryBudgetExceeded)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("error_not_retried_api_error", func(t *testing.T) {
+		apiResp := &Response{
+			Ok:    false,
+			Error: &Error{ErrorCode: 400},
+		}
+		caller := &testRetryCaller{
+			failResp: apiResp,
+		}
+		retryCaller := &RetryCaller{
+			Caller:      caller,
+			MaxAttempts: 3,
+		}
+
+		resp, err := retryCaller.Call(ctx, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, apiResp, resp)
+		assert.Equal(t, 1, caller.attempts)
+	})
+
+	t.Run("error_max_attempts_api_error", func(t *testing.T) {
+		apiResp := &Response{
+			Ok:    false,
+			Error: &Error{ErrorCode: 429},
+		}
+		caller := &testRetryCaller{
+			failResp: apiResp,
+		}
+		retryCaller := &RetryCaller{
+			Caller:      caller,
+			MaxAttempts: 2,
+			Policy:      testRetryPolicy{},
+		}
+
+		resp, err := retryCaller.Call(ctx, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, apiResp, resp)
+		assert.Equal(t, 2, caller.attempts)
+	})
+}
+
+func TestFastHTTPCaller_Call_streaming(t *testing.T) {
+	ln := fasthttputil.NewInmemoryListener()
+	defer func() { _ = ln.Close() }()
+
+	var body string
+	var contentLength int
+	srv := fasthttp.Server{
+		Handler: func(ctx *fasthttp.RequestCtx) {
+			body = string(ctx.PostBody())
+			contentLength = ctx.Request.Header.ContentLength()
+			_, _ = ctx.WriteString(`{"ok": true}`)
+		},
+	}
+	go func() { _ = srv.Serve(ln) }()
+
+	caller := FastHTTPCaller{Client: &fasthttp.Client{
+		Dial: func(addr string) (net.Conn, error) {
+			return ln.Dial()
+		},
+	}}
+
+	t.Run("known_size", func(t *testing.T) {
+		resp, err := caller.Call(context.Background(), "http://localhost", &RequestData{
+			ContentType: ContentTypeJSON,
+			BodyStream:  strings.NewReader("test"),
+			BodySize:    4,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Ok)
+		assert.Equal(t, "test", body)
+		assert.Equal(t, 4, contentLength)
+	})
+
+	t.Run("unknown_size", func(t *testing.T) {
+		resp, err := caller.Call(context.Background(), "http://localhost", &RequestData{
+			ContentType: ContentTypeJSON,
+			BodyStream:  strings.NewReader("test"),
+			BodySize:    -1,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Ok)
+		assert.Equal(t, "test", body)
+	})
+}
+
+func TestHTTPCaller_Call_streaming(t *testing.T) {
+	var body string
+	var contentLength int64
+	srv := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		data, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+
+		body = string(data)
+		contentLength = req.ContentLength
+		_, _ = resp.Write([]byte(`{"ok": true}`))
+	}))
+	defer srv.Close()
+
+	caller := HTTPCaller{Client: srv.Client()}
+
+	t.Run("known_size", func(t *testing.T) {
+		resp, err := caller.Call(context.Background(), srv.URL, &RequestData{
+			ContentType: ContentTypeJSON,
+			BodyStream:  strings.NewReader("test"),
+			BodySize:    4,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Ok)
+		assert.Equal(t, "test", body)
+		assert.EqualValues(t, 4, contentLength)
+	})
+
+	t.Run("unknown_size", func(t *testing.T) {
+		resp, err := caller.Call(context.Background(), srv.URL, &RequestData{
+			ContentType: ContentTypeJSON,
+			BodyStream:  strings.NewReader("test"),
+			BodySize:    -1,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Ok)
+		assert.Equal(t, "test", body)
+		assert.EqualValues(t, -1, contentLength)
+	})
+}
+
+func TestRetryCaller_Call_streaming(t *testing.T) {
+	caller := &testRetryCaller{err: errors.New("test")}
+	retryCaller := &RetryCaller{
+		Caller:      caller,
+		MaxAttempts: 3,
+		Policy:      testRetryPolicy{},
+	}
+
+	_, err := retryCaller.Call(context.Background(), "", &RequestData{BodyStream: strings.NewReader("test")})
+	require.Error(t, err)
+	assert.Equal(t, 1, caller.attempts)
 }
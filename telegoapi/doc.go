@@ -10,8 +10,8 @@ Telego provides valyala/fasthttp and net/http implementation, but your own can b
 via telego.BotOption's.
 
 RequestConstructor interface represents a general way of constructing RequestData used in Caller. Currently, Telego
-provides an only default implementation that uses goccy/go-json instead of encoding/json and std mime/multipart
-package.
+provides default implementation that uses goccy/go-json instead of encoding/json and std mime/multipart package, and
+streaming implementation that doesn't buffer files in memory, but writes them directly to the request body.
 
 NamedReader interface represents a general way of sending files that are provided to RequestConstructor. As io.Reader
 can be provided, any valid reader and name method should return a unique name for every file in one request, otherwise
@@ -0,0 +1,199 @@
+package telegoapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/valyala/fasthttp"
+)
+
+// Downloader represents way to download files, it can be implemented by [Caller] to download files using the same
+// client as for API calls
+type Downloader interface {
+	// Download writes file from URL to dst starting from offset (in bytes) and returns number of bytes written,
+	// offset is requested using Range header, if server ignores it, the first offset bytes are skipped
+	Download(ctx context.Context, url string, offset int64, dst io.Writer) (int64, error)
+}
+
+// ErrDownloadNotSupported returned when caller doesn't implement [Downloader]
+var ErrDownloadNotSupported = errors.New("caller doesn't support downloads")
+
+// Download downloads file using caller if it implements [Downloader]
+func Download(ctx context.Context, caller Caller, url string, offset int64, dst io.Writer) (int64, error) {
+	downloader, ok := caller.(Downloader)
+	if !ok {
+		return 0, ErrDownloadNotSupported
+	}
+	return downloader.Download(ctx, url, offset, dst)
+}
+
+// rangeHeader HTTP range request header
+const rangeHeader = "Range"
+
+// rangeValue returns value of Range header for offset
+func rangeValue(offset int64) string {
+	return "bytes=" + strconv.FormatInt(offset, 10) + "-"
+}
+
+// copyDownload copies response body to dst, skipping offset bytes if server ignored range request
+func copyDownload(ctx context.Context, statusCode int, offset int64, body io.Reader, dst io.Writer) (int64, error) {
+	switch {
+	case statusCode == http.StatusPartialContent:
+		// Server returned requested range
+	case statusCode == http.StatusOK:
+		if offset > 0 {
+			if _, err := io.CopyN(io.Discard, body, offset); err != nil {
+				return 0, fmt.Errorf("skip offset: %w", err)
+			}
+		}
+	default:
+		return 0, fmt.Errorf("unexpected status code: %d", statusCode)
+	}
+
+	n, err := io.Copy(contextWriter{ctx: ctx, writer: dst}, body)
+	if err != nil {
+		return n, fmt.Errorf("copy body: %w", err)
+	}
+	return n, nil
+}
+
+// contextWriter writer that stops writing once context is done
+type contextWriter struct {
+	ctx    context.Context //nolint:containedctx
+	writer io.Writer
+}
+
+func (w contextWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.writer.Write(p)
+}
+
+// Download is a fasthttp implementation, download client is used if set
+// Note: Response body is streamed only if client has StreamResponseBody enabled, otherwise it's read into memory
+// before writing to dst. As fasthttp doesn't support context, cancellation of the context makes the download return
+// immediately and stops writing to dst, while the request itself is finished in background
+func (a FastHTTPCaller) Download(ctx context.Context, url string, offset int64, dst io.Writer) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("fasthttp do request: %w", err)
+	}
+
+	client := a.DownloadClient
+	if client == nil {
+		client = a.Client
+	}
+
+	writer := &stoppableWriter{writer: dst}
+	done := make(chan error, 1)
+	go func() {
+		req := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(req)
+		resp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(resp)
+
+		req.SetRequestURI(url)
+		req.Header.SetMethod(fasthttp.MethodGet)
+		if offset > 0 {
+			req.Header.Set(rangeHeader, rangeValue(offset))
+		}
+
+		var err error
+		if deadline, ok := ctx.Deadline(); ok {
+			err = client.DoDeadline(req, resp, deadline)
+		} else {
+			err = client.Do(req, resp)
+		}
+		if err != nil {
+			done <- fmt.Errorf("fasthttp do request: %w", err)
+			return
+		}
+
+		if bodyStream := resp.BodyStream(); bodyStream != nil {
+			_, err = copyDownload(ctx, resp.StatusCode(), offset, bodyStream, writer)
+		} else {
+			_, err = copyDownload(ctx, resp.StatusCode(), offset, bytes.NewReader(resp.Body()), writer)
+		}
+		if err != nil {
+			// Connection can't be reused if body wasn't fully read
+			resp.SetConnectionClose()
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return writer.written, err
+	case <-ctx.Done():
+		return writer.stop(), fmt.Errorf("fasthttp do request: %w", ctx.Err())
+	}
+}
+
+// stoppableWriter writer that counts written bytes and can be stopped from another goroutine, after it's stopped no
+// more writes reach the underlying writer
+type stoppableWriter struct {
+	lock    sync.Mutex
+	writer  io.Writer
+	written int64
+	stopped bool
+}
+
+func (w *stoppableWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.stopped {
+		return 0, errWriterStopped
+	}
+
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// stop stops writer and returns number of bytes written, it waits for write in progress to finish
+func (w *stoppableWriter) stop() int64 {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.stopped = true
+	return w.written
+}
+
+// errWriterStopped returned when writing to stopped writer
+var errWriterStopped = errors.New("writer stopped")
+
+// Download is a http implementation
+func (h HTTPCaller) Download(ctx context.Context, url string, offset int64, dst io.Writer) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("http create request: %w", err)
+	}
+	if offset > 0 {
+		req.Header.Set(rangeHeader, rangeValue(offset))
+	}
+
+	resp, err := h.Client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("http do request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck
+
+	return copyDownload(ctx, resp.StatusCode, offset, resp.Body, dst)
+}
+
+// Download downloads file using underlying caller without retries
+func (r *RetryCaller) Download(ctx context.Context, url string, offset int64, dst io.Writer) (int64, error) {
+	return Download(ctx, r.Caller, url, offset, dst)
+}
+
+// Download downloads file using underlying caller without rate limits
+func (r *RateLimitCaller) Download(ctx context.Context, url string, offset int64, dst io.Writer) (int64, error) {
+	return Download(ctx, r.Caller, url, offset, dst)
+}
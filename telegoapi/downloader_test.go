@@ -0,0 +1,144 @@
+package telegoapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	testDownloadContent = "Hello World"
+	testDownloadStall   = time.Second
+)
+
+func downloadHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/no_range":
+		_, _ = w.Write([]byte(testDownloadContent))
+	case "/not_found":
+		w.WriteHeader(http.StatusNotFound)
+	case "/stall":
+		w.Header().Set("Content-Length", strconv.Itoa(len(testDownloadContent)*2))
+		_, _ = w.Write([]byte(testDownloadContent))
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(testDownloadStall):
+		}
+		panic(http.ErrAbortHandler)
+	default:
+		http.ServeContent(w, r, "file.txt", time.Time{}, strings.NewReader(testDownloadContent))
+	}
+}
+
+func testDownloader(t *testing.T, downloader Downloader, url string) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		n, err := downloader.Download(ctx, url, 0, buf)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(testDownloadContent), n)
+		assert.Equal(t, testDownloadContent, buf.String())
+	})
+
+	t.Run("success_range", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		n, err := downloader.Download(ctx, url, 6, buf)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, n)
+		assert.Equal(t, "World", buf.String())
+	})
+
+	t.Run("success_range_ignored", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		n, err := downloader.Download(ctx, url+"/no_range", 6, buf)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, n)
+		assert.Equal(t, "World", buf.String())
+	})
+
+	t.Run("error_status", func(t *testing.T) {
+		_, err := downloader.Download(ctx, url+"/not_found", 0, &bytes.Buffer{})
+		require.Error(t, err)
+	})
+
+	t.Run("error_context_canceled_body_stalled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		time.AfterFunc(testDownloadStall/10, cancel)
+
+		buf := &bytes.Buffer{}
+		start := time.Now()
+		n, err := downloader.Download(canceledCtx, url+"/stall", 0, buf)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(start), testDownloadStall/2)
+		assert.EqualValues(t, buf.Len(), n)
+	})
+
+	t.Run("error_context_canceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := downloader.Download(canceledCtx, url, 0, &bytes.Buffer{})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestFastHTTPCaller_Download(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(downloadHandler))
+	defer srv.Close()
+
+	for _, stream := range []bool{false, true} {
+		caller := FastHTTPCaller{Client: &fasthttp.Client{StreamResponseBody: stream}}
+		testDownloader(t, caller, srv.URL)
+	}
+}
+
+func TestHTTPCaller_Download(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(downloadHandler))
+	defer srv.Close()
+
+	caller := HTTPCaller{Client: srv.Client()}
+	testDownloader(t, caller, srv.URL)
+
+	_, err := caller.Download(context.Background(), "\x00", 0, &bytes.Buffer{})
+	require.Error(t, err)
+}
+
+func TestDownload(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(downloadHandler))
+	defer srv.Close()
+
+	caller := HTTPCaller{Client: srv.Client()}
+
+	t.Run("retry_caller", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		_, err := Download(context.Background(), &RetryCaller{Caller: caller}, srv.URL, 0, buf)
+		require.NoError(t, err)
+		assert.Equal(t, testDownloadContent, buf.String())
+	})
+
+	t.Run("rate_limit_caller", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		_, err := Download(context.Background(), &RateLimitCaller{Caller: caller}, srv.URL, 0, buf)
+		require.NoError(t, err)
+		assert.Equal(t, testDownloadContent, buf.String())
+	})
+
+	t.Run("not_supported", func(t *testing.T) {
+		_, err := Download(context.Background(), &testRetryCaller{}, srv.URL, 0, &bytes.Buffer{})
+		require.ErrorIs(t, err, ErrDownloadNotSupported)
+	})
+}
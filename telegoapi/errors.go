@@ -0,0 +1,148 @@
+package telegoapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error kinds that can be matched against [Error] using [errors.Is], for example:
+//
+//	if errors.Is(err, telegoapi.ErrBotBlocked) { ... }
+//
+// Kinds based on error code (like [ErrForbidden]) match any error with that code, while kinds based on
+// description (like [ErrBotBlocked]) match only errors with the corresponding code and description.
+var (
+	// ErrBadRequest - Request is malformed or can't be executed (400)
+	ErrBadRequest = errors.New("bad request")
+
+	// ErrUnauthorized - Bot token is invalid (401)
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden - Bot has no rights to execute the request (403)
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrNotFound - Method or resource not found (404)
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict - Request conflicts with another one, for example, concurrent getUpdates or active webhook (409)
+	ErrConflict = errors.New("conflict")
+
+	// ErrTooManyRequests - Flood control exceeded, see [RetryAfter] (429)
+	ErrTooManyRequests = errors.New("too many requests")
+
+	// ErrServer - Telegram server error (5xx)
+	ErrServer = errors.New("server error")
+
+	// ErrBotBlocked - Bot was blocked by the user (403)
+	ErrBotBlocked = errors.New("bot was blocked by the user")
+
+	// ErrBotKicked - Bot was kicked from the chat (403)
+	ErrBotKicked = errors.New("bot was kicked from the chat")
+
+	// ErrUserDeactivated - User is deactivated (403)
+	ErrUserDeactivated = errors.New("user is deactivated")
+
+	// ErrChatNotFound - Chat not found (400)
+	ErrChatNotFound = errors.New("chat not found")
+
+	// ErrMessageNotModified - Message is not modified by edit request (400)
+	ErrMessageNotModified = errors.New("message is not modified")
+
+	// ErrMessageToEditNotFound - Message to edit not found (400)
+	ErrMessageToEditNotFound = errors.New("message to edit not found")
+
+	// ErrMessageToDeleteNotFound - Message to delete not found (400)
+	ErrMessageToDeleteNotFound = errors.New("message to delete not found")
+
+	// ErrWebhookActive - Updates can't be received using getUpdates while webhook is set (409)
+	ErrWebhookActive = errors.New("webhook is active")
+
+	// ErrChatMigrated - Group was migrated to a supergroup, see [MigrateTo] (400)
+	ErrChatMigrated = errors.New("group chat was migrated to a supergroup")
+)
+
+// errorDescriptionKind represents error kind matched by error code and description
+type errorDescriptionKind struct {
+	code        int
+	description string
+}
+
+// errorDescriptionKinds maps description based error kinds to their code and lowercase description substring
+var errorDescriptionKinds = map[error]errorDescriptionKind{
+	ErrBotBlocked:              {code: http.StatusForbidden, description: "bot was blocked by the user"},
+	ErrBotKicked:               {code: http.StatusForbidden, description: "bot was kicked"},
+	ErrUserDeactivated:         {code: http.StatusForbidden, description: "user is deactivated"},
+	ErrChatNotFound:            {code: http.StatusBadRequest, description: "chat not found"},
+	ErrMessageNotModified:      {code: http.StatusBadRequest, description: "message is not modified"},
+	ErrMessageToEditNotFound:   {code: http.StatusBadRequest, description: "message to edit not found"},
+	ErrMessageToDeleteNotFound: {code: http.StatusBadRequest, description: "message to delete not found"},
+	ErrWebhookActive:           {code: http.StatusConflict, description: "webhook is active"},
+}
+
+// Is reports whether error matches one of error kinds (like [ErrBotBlocked]), used by [errors.Is]
+func (a *Error) Is(target error) bool {
+	if a == nil {
+		return false
+	}
+
+	switch target {
+	case ErrBadRequest:
+		return a.ErrorCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return a.ErrorCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return a.ErrorCode == http.StatusForbidden
+	case ErrNotFound:
+		return a.ErrorCode == http.StatusNotFound
+	case ErrConflict:
+		return a.ErrorCode == http.StatusConflict
+	case ErrTooManyRequests:
+		return a.ErrorCode == http.StatusTooManyRequests ||
+			(a.Parameters != nil && a.Parameters.RetryAfter > 0)
+	case ErrServer:
+		return a.ErrorCode >= http.StatusInternalServerError
+	case ErrChatMigrated:
+		return a.Parameters != nil && a.Parameters.MigrateToChatID != 0
+	}
+
+	kind, ok := errorDescriptionKinds[target]
+	if !ok {
+		return false
+	}
+
+	return a.ErrorCode == kind.code && strings.Contains(strings.ToLower(a.Description), kind.description)
+}
+
+// AsError returns [Error] from error chain if present
+func AsError(err error) (*Error, bool) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr != nil {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsBlocked returns true if error is caused by user blocking the bot
+func IsBlocked(err error) bool {
+	return errors.Is(err, ErrBotBlocked)
+}
+
+// RetryAfter returns the duration to wait before the request can be repeated if error is caused by flood control
+func RetryAfter(err error) (time.Duration, bool) {
+	apiErr, ok := AsError(err)
+	if !ok || apiErr.Parameters == nil || apiErr.Parameters.RetryAfter <= 0 {
+		return 0, false
+	}
+	return time.Duration(apiErr.Parameters.RetryAfter) * time.Second, true
+}
+
+// MigrateTo returns ID of the supergroup the group was migrated to if error is caused by chat migration
+func MigrateTo(err error) (int64, bool) {
+	apiErr, ok := AsError(err)
+	if !ok || apiErr.Parameters == nil || apiErr.Parameters.MigrateToChatID == 0 {
+		return 0, false
+	}
+	return apiErr.Parameters.MigrateToChatID, true
+}
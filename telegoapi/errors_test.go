@@ -0,0 +1,195 @@
+package telegoapi
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     *Error
+		kinds   []error
+		noKinds []error
+	}{
+		{
+			name:    "bot_blocked",
+			err:     &Error{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"},
+			kinds:   []error{ErrForbidden, ErrBotBlocked},
+			noKinds: []error{ErrBadRequest, ErrBotKicked, ErrChatNotFound},
+		},
+		{
+			name:    "bot_kicked",
+			err:     &Error{ErrorCode: 403, Description: "Forbidden: bot was kicked from the group chat"},
+			kinds:   []error{ErrForbidden, ErrBotKicked},
+			noKinds: []error{ErrBotBlocked},
+		},
+		{
+			name:  "user_deactivated",
+			err:   &Error{ErrorCode: 403, Description: "Forbidden: user is deactivated"},
+			kinds: []error{ErrForbidden, ErrUserDeactivated},
+		},
+		{
+			name:    "chat_not_found",
+			err:     &Error{ErrorCode: 400, Description: "Bad Request: chat not found"},
+			kinds:   []error{ErrBadRequest, ErrChatNotFound},
+			noKinds: []error{ErrForbidden, ErrMessageNotModified},
+		},
+		{
+			name: "message_not_modified",
+			err: &Error{
+				ErrorCode:   400,
+				Description: "Bad Request: message is not modified: specified new message content is the same",
+			},
+			kinds: []error{ErrBadRequest, ErrMessageNotModified},
+		},
+		{
+			name:  "message_to_edit_not_found",
+			err:   &Error{ErrorCode: 400, Description: "Bad Request: message to edit not found"},
+			kinds: []error{ErrBadRequest, ErrMessageToEditNotFound},
+		},
+		{
+			name:  "message_to_delete_not_found",
+			err:   &Error{ErrorCode: 400, Description: "Bad Request: message to delete not found"},
+			kinds: []error{ErrBadRequest, ErrMessageToDeleteNotFound},
+		},
+		{
+			name: "webhook_active",
+			err: &Error{
+				ErrorCode: 409,
+				Description: "Conflict: can't use getUpdates method while webhook is active; " +
+					"use deleteWebhook to delete the webhook first",
+			},
+			kinds: []error{ErrConflict, ErrWebhookActive},
+		},
+		{
+			name:    "conflict",
+			err:     &Error{ErrorCode: 409, Description: "Conflict: terminated by other getUpdates request"},
+			kinds:   []error{ErrConflict},
+			noKinds: []error{ErrWebhookActive},
+		},
+		{
+			name: "chat_migrated",
+			err: &Error{
+				ErrorCode:   400,
+				Description: "Bad Request: group chat was upgraded to a supergroup chat",
+				Parameters:  &ResponseParameters{MigrateToChatID: -100123},
+			},
+			kinds:   []error{ErrBadRequest, ErrChatMigrated},
+			noKinds: []error{ErrTooManyRequests},
+		},
+		{
+			name: "too_many_requests",
+			err: &Error{
+				ErrorCode:   429,
+				Description: "Too Many Requests: retry after 5",
+				Parameters:  &ResponseParameters{RetryAfter: 5},
+			},
+			kinds:   []error{ErrTooManyRequests},
+			noKinds: []error{ErrBadRequest, ErrChatMigrated, ErrServer},
+		},
+		{
+			name:  "unauthorized",
+			err:   &Error{ErrorCode: 401, Description: "Unauthorized"},
+			kinds: []error{ErrUnauthorized},
+		},
+		{
+			name:  "not_found",
+			err:   &Error{ErrorCode: 404, Description: "Not Found"},
+			kinds: []error{ErrNotFound},
+		},
+		{
+			name:  "conflict",
+			err:   &Error{ErrorCode: 409, Description: "Conflict: terminated by other getUpdates request"},
+			kinds: []error{ErrConflict},
+		},
+		{
+			name:  "server",
+			err:   &Error{ErrorCode: 502, Description: "Bad Gateway"},
+			kinds: []error{ErrServer},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("api: %w", tt.err)
+
+			for _, kind := range tt.kinds {
+				assert.ErrorIs(t, err, kind)
+			}
+			for _, kind := range tt.noKinds {
+				assert.NotErrorIs(t, err, kind)
+			}
+			assert.NotErrorIs(t, err, errors.New("test"))
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		var err *Error
+		assert.False(t, err.Is(ErrBadRequest))
+	})
+}
+
+func TestAsError(t *testing.T) {
+	apiErr := &Error{ErrorCode: 400}
+
+	err, ok := AsError(fmt.Errorf("api: %w", apiErr))
+	assert.True(t, ok)
+	assert.Equal(t, apiErr, err)
+
+	err, ok = AsError(errors.New("test"))
+	assert.False(t, ok)
+	assert.Nil(t, err)
+
+	err, ok = AsError(nil)
+	assert.False(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestIsBlocked(t *testing.T) {
+	assert.True(t, IsBlocked(fmt.Errorf("api: %w", &Error{
+		ErrorCode:   403,
+		Description: "Forbidden: bot was blocked by the user",
+	})))
+	assert.False(t, IsBlocked(&Error{ErrorCode: 403, Description: "Forbidden: user is deactivated"}))
+	assert.False(t, IsBlocked(errors.New("bot was blocked by the user")))
+	assert.False(t, IsBlocked(nil))
+}
+
+func TestRetryAfter(t *testing.T) {
+	retryAfter, ok := RetryAfter(fmt.Errorf("api: %w", &Error{
+		ErrorCode:  429,
+		Parameters: &ResponseParameters{RetryAfter: 3},
+	}))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, retryAfter)
+
+	retryAfter, ok = RetryAfter(&Error{ErrorCode: 429})
+	assert.False(t, ok)
+	assert.Zero(t, retryAfter)
+
+	retryAfter, ok = RetryAfter(errors.New("test"))
+	assert.False(t, ok)
+	assert.Zero(t, retryAfter)
+}
+
+func TestMigrateTo(t *testing.T) {
+	chatID, ok := MigrateTo(fmt.Errorf("api: %w", &Error{
+		ErrorCode:  400,
+		Parameters: &ResponseParameters{MigrateToChatID: -100123},
+	}))
+	assert.True(t, ok)
+	assert.Equal(t, int64(-100123), chatID)
+
+	chatID, ok = MigrateTo(&Error{ErrorCode: 400, Parameters: &ResponseParameters{RetryAfter: 1}})
+	assert.False(t, ok)
+	assert.Zero(t, chatID)
+
+	chatID, ok = MigrateTo(errors.New("test"))
+	assert.False(t, ok)
+	assert.Zero(t, chatID)
+}
@@ -0,0 +1,343 @@
+package telegoapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/mymmrac/telego/internal/json"
+	"github.com/mymmrac/telego/internal/ratelimit"
+)
+
+// RateLimit represents rate limit of Count events per time period Per
+type RateLimit struct {
+	// Count - Number of events allowed per time period
+	Count int
+
+	// Per - Time period
+	Per time.Duration
+
+	// Burst - Number of events that can be made at once without waiting, 1 used if zero
+	Burst int
+}
+
+// applyTo sets limit of schedule
+func (l RateLimit) applyTo(schedule *ratelimit.Schedule) {
+	schedule.SetLimit(l.Count, l.Per, l.Burst)
+}
+
+// Default rate limits as documented by Telegram, more details:
+// https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this
+var (
+	// DefaultGlobalRateLimit default limit of messages sent to all chats
+	DefaultGlobalRateLimit = RateLimit{Count: 30, Per: time.Second}
+
+	// DefaultPrivateChatRateLimit default limit of messages sent to one private chat
+	DefaultPrivateChatRateLimit = RateLimit{Count: 1, Per: time.Second}
+
+	// DefaultGroupChatRateLimit default limit of messages sent to one group or channel
+	DefaultGroupChatRateLimit = RateLimit{Count: 20, Per: time.Minute}
+
+	// NoRateLimit disables rate limit
+	NoRateLimit = RateLimit{Count: -1}
+)
+
+// RateLimitWait represents information about call delayed by [RateLimitCaller]
+type RateLimitWait struct {
+	// Method - Name of the API method, extracted from request URL
+	Method string
+
+	// ChatID - Chat ID (or username) extracted from request, empty if request has no chat ID
+	ChatID string
+
+	// Wait - Time call waited before being executed
+	Wait time.Duration
+}
+
+// RateLimitCaller decorator over Caller that paces outgoing messages to not exceed Telegram limits, calls that
+// exceed limits are queued (in order of calling for each chat) instead of failing
+//
+// Note: Limits are applied only to methods that send messages (see [IsRateLimitedMethod]), to disable specific limit
+// use [NoRateLimit]. Limits can be increased for bots with paid broadcasts enabled.
+// Warning: Zero value is ready to use, but [RateLimitCaller] must not be copied after first use.
+type RateLimitCaller struct {
+	Caller Caller
+
+	// GlobalLimit - Limit of messages to all chats, [DefaultGlobalRateLimit] used if zero
+	GlobalLimit RateLimit
+
+	// PrivateChatLimit - Limit of messages to one private chat, [DefaultPrivateChatRateLimit] used if zero
+	PrivateChatLimit RateLimit
+
+	// GroupChatLimit - Limit of messages to one group or channel, [DefaultGroupChatRateLimit] used if zero
+	GroupChatLimit RateLimit
+
+	// Limited - Reports if method should be rate limited, [IsRateLimitedMethod] used if nil
+	Limited func(method string) bool
+
+	// OnWait - Optional hook that is called when call was delayed by rate limit before executing it
+	OnWait func(wait RateLimitWait)
+
+	lock         sync.Mutex
+	global       ratelimit.Schedule
+	chats        map[string]*rateChat
+	queued       int
+	reservations int
+}
+
+// rateChat represents rate limit state of one chat
+type rateChat struct {
+	schedule ratelimit.Schedule
+	queued   int
+}
+
+// rateChatsSweepInterval number of reservations after which outdated chats are removed
+const rateChatsSweepInterval = 1024
+
+// Call makes calls using provided caller, waiting for rate limits if needed
+func (r *RateLimitCaller) Call(ctx context.Context, url string, data *RequestData) (*Response, error) {
+	method := methodFromURL(url)
+
+	limited := r.Limited
+	if limited == nil {
+		limited = IsRateLimitedMethod
+	}
+	if !limited(method) {
+		return r.Caller.Call(ctx, url, data)
+	}
+
+	chatID := chatIDFromRequest(data)
+	start := time.Now()
+
+	if err := r.wait(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+
+	if wait := time.Since(start); wait > 0 && r.OnWait != nil {
+		r.OnWait(RateLimitWait{
+			Method: method,
+			ChatID: chatID,
+			Wait:   wait,
+		})
+	}
+
+	return r.Caller.Call(ctx, url, data)
+}
+
+// QueueDepth returns number of calls currently waiting for rate limits
+func (r *RateLimitCaller) QueueDepth() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.queued
+}
+
+// ChatQueueDepth returns number of calls to specific chat currently waiting for rate limits
+func (r *RateLimitCaller) ChatQueueDepth(chatID string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if chat, ok := r.chats[chatID]; ok {
+		return chat.queued
+	}
+	return 0
+}
+
+// wait blocks until call to chat is allowed by chat and global limits
+func (r *RateLimitCaller) wait(ctx context.Context, chatID string) error {
+	r.lock.Lock()
+	r.queued++
+
+	var chat *rateChat
+	var reservation ratelimit.Reservation
+	if chatID != "" {
+		chat = r.chat(chatID)
+		chat.queued++
+		reservation = chat.schedule.Reserve(time.Now())
+	}
+	r.lock.Unlock()
+
+	defer func() {
+		r.lock.Lock()
+		r.queued--
+		if chat != nil {
+			chat.queued--
+		}
+		r.lock.Unlock()
+	}()
+
+	// Wait for chat limit first, so the global limit is shared fairly between chats ready to send
+	if err := ratelimit.SleepUntil(ctx, reservation.AllowAt); err != nil {
+		if chat != nil {
+			r.lock.Lock()
+			chat.schedule.Cancel(reservation)
+			r.lock.Unlock()
+		}
+		return err
+	}
+
+	r.lock.Lock()
+	limitOrDefault(r.GlobalLimit, DefaultGlobalRateLimit).applyTo(&r.global)
+	globalReservation := r.global.Reserve(time.Now())
+	r.lock.Unlock()
+
+	if err := ratelimit.SleepUntil(ctx, globalReservation.AllowAt); err != nil {
+		r.lock.Lock()
+		r.global.Cancel(globalReservation)
+		if chat != nil {
+			chat.schedule.Cancel(reservation)
+		}
+		r.lock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// chat returns rate limit state of chat, must be called with lock held
+func (r *RateLimitCaller) chat(chatID string) *rateChat {
+	if r.chats == nil {
+		r.chats = make(map[string]*rateChat)
+	}
+
+	r.reservations++
+	if r.reservations%rateChatsSweepInterval == 0 {
+		now := time.Now()
+		for id, chat := range r.chats {
+			if chat.queued == 0 && chat.schedule.Idle(now) {
+				delete(r.chats, id)
+			}
+		}
+	}
+
+	chat, ok := r.chats[chatID]
+	if !ok {
+		chat = &rateChat{}
+		r.chats[chatID] = chat
+	}
+
+	if isPrivateChatID(chatID) {
+		limitOrDefault(r.PrivateChatLimit, DefaultPrivateChatRateLimit).applyTo(&chat.schedule)
+	} else {
+		limitOrDefault(r.GroupChatLimit, DefaultGroupChatRateLimit).applyTo(&chat.schedule)
+	}
+
+	return chat
+}
+
+// limitOrDefault returns limit or default limit if limit is zero
+func limitOrDefault(limit, defaultLimit RateLimit) RateLimit {
+	if limit == (RateLimit{}) {
+		return defaultLimit
+	}
+	return limit
+}
+
+// rateLimitedMethodPrefixes list of method name prefixes that send messages
+var rateLimitedMethodPrefixes = []string{
+	"send",
+	"forward",
+	"copy",
+}
+
+// IsRateLimitedMethod returns true if method sends messages and is subject to Telegram rate limits
+func IsRateLimitedMethod(method string) bool {
+	for _, prefix := range rateLimitedMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrivateChatID returns true if chat ID belongs to private chat (users have positive IDs)
+func isPrivateChatID(chatID string) bool {
+	return chatID != "" && chatID[0] != '-' && chatID[0] != '@'
+}
+
+// chatIDParameter name of chat ID parameter
+const chatIDParameter = "chat_id"
+
+// chatIDFromRequest returns chat ID set by request constructor or parsed from request body if it's not set (for
+// custom constructors), empty string if not found
+func chatIDFromRequest(data *RequestData) string {
+	if data == nil {
+		return ""
+	}
+	if data.ChatID != "" {
+		return data.ChatID
+	}
+	if data.Buffer == nil {
+		return ""
+	}
+
+	mediaType, params, err := mime.ParseMediaType(data.ContentType)
+	if err != nil {
+		return ""
+	}
+
+	switch mediaType {
+	case ContentTypeJSON:
+		return chatIDFromJSON(data.Buffer.Bytes())
+	case "multipart/form-data":
+		return chatIDFromMultipart(data.Buffer.Bytes(), params["boundary"])
+	default:
+		return ""
+	}
+}
+
+// chatIDFromJSON returns chat ID from JSON request
+func chatIDFromJSON(data []byte) string {
+	parser := json.ParserPoll.Get()
+	defer json.ParserPoll.Put(parser)
+
+	value, err := parser.ParseBytes(data)
+	if err != nil {
+		return ""
+	}
+
+	chatID := value.Get(chatIDParameter)
+	if chatID == nil {
+		return ""
+	}
+
+	switch chatID.Type() {
+	case fastjson.TypeNumber:
+		return chatID.String()
+	case fastjson.TypeString:
+		return string(chatID.GetStringBytes())
+	default:
+		return ""
+	}
+}
+
+// chatIDFromMultipart returns chat ID from multipart request
+func chatIDFromMultipart(data []byte, boundary string) string {
+	if boundary == "" {
+		return ""
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(data), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return ""
+		}
+
+		if part.FormName() != chatIDParameter {
+			continue
+		}
+
+		chatID, err := io.ReadAll(part)
+		if err != nil {
+			return ""
+		}
+		return string(chatID)
+	}
+}
@@ -0,0 +1,288 @@
+package telegoapi
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRateURL      = "https://api.telegram.org/bot1:token/sendMessage"
+	testRateInterval = 50 * time.Millisecond
+)
+
+type testRateCaller struct {
+	lock  sync.Mutex
+	calls []time.Time
+}
+
+func (t *testRateCaller) Call(_ context.Context, _ string, _ *RequestData) (*Response, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.calls = append(t.calls, time.Now())
+	return &Response{Ok: true}, nil
+}
+
+func testRateRequest(t *testing.T, chatID any) *RequestData {
+	t.Helper()
+
+	data, err := DefaultConstructor{}.JSONRequest(map[string]any{"chat_id": chatID})
+	require.NoError(t, err)
+	return data
+}
+
+func TestRateLimitCaller_Call(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not_limited_method", func(t *testing.T) {
+		caller := &testRateCaller{}
+		rateCaller := &RateLimitCaller{
+			Caller:           caller,
+			PrivateChatLimit: RateLimit{Count: 1, Per: time.Hour},
+		}
+
+		for i := 0; i < 3; i++ {
+			resp, err := rateCaller.Call(ctx, "https://api.telegram.org/bot1:token/getMe", testRateRequest(t, 1))
+			require.NoError(t, err)
+			assert.True(t, resp.Ok)
+		}
+		assert.Len(t, caller.calls, 3)
+	})
+
+	t.Run("private_chat", func(t *testing.T) {
+		caller := &testRateCaller{}
+
+		var waits []RateLimitWait
+		rateCaller := &RateLimitCaller{
+			Caller:           caller,
+			GlobalLimit:      NoRateLimit,
+			PrivateChatLimit: RateLimit{Count: 1, Per: testRateInterval},
+			OnWait: func(wait RateLimitWait) {
+				waits = append(waits, wait)
+			},
+		}
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			_, err := rateCaller.Call(ctx, testRateURL, testRateRequest(t, 1))
+			require.NoError(t, err)
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 2*testRateInterval)
+		require.NotEmpty(t, waits)
+		assert.Equal(t, "sendMessage", waits[0].Method)
+		assert.Equal(t, "1", waits[0].ChatID)
+		assert.Zero(t, rateCaller.QueueDepth())
+	})
+
+	t.Run("group_chat_burst", func(t *testing.T) {
+		caller := &testRateCaller{}
+		rateCaller := &RateLimitCaller{
+			Caller:         caller,
+			GlobalLimit:    NoRateLimit,
+			GroupChatLimit: RateLimit{Count: 2, Per: time.Hour, Burst: 2},
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := rateCaller.Call(ctx, testRateURL, testRateRequest(t, "@channel"))
+			require.NoError(t, err)
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, testRateInterval)
+		defer cancel()
+
+		_, err := rateCaller.Call(timeoutCtx, testRateURL, testRateRequest(t, -100))
+		require.NoError(t, err)
+
+		_, err = rateCaller.Call(timeoutCtx, testRateURL, testRateRequest(t, -100))
+		require.NoError(t, err)
+
+		_, err = rateCaller.Call(timeoutCtx, testRateURL, testRateRequest(t, "@channel"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, caller.calls, 4)
+	})
+
+	t.Run("chats_independent", func(t *testing.T) {
+		caller := &testRateCaller{}
+		rateCaller := &RateLimitCaller{
+			Caller:           caller,
+			GlobalLimit:      NoRateLimit,
+			PrivateChatLimit: RateLimit{Count: 1, Per: time.Hour},
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, testRateInterval)
+		defer cancel()
+
+		for i := 1; i <= 3; i++ {
+			_, err := rateCaller.Call(timeoutCtx, testRateURL, testRateRequest(t, i))
+			require.NoError(t, err)
+		}
+		assert.Len(t, caller.calls, 3)
+	})
+
+	t.Run("streaming", func(t *testing.T) {
+		caller := &testRateCaller{}
+		rateCaller := &RateLimitCaller{
+			Caller:           caller,
+			GlobalLimit:      NoRateLimit,
+			PrivateChatLimit: RateLimit{Count: 1, Per: testRateInterval},
+		}
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			data, err := StreamingConstructor{}.MultipartRequest(
+				map[string]string{"chat_id": "1"},
+				map[string]NamedReader{"video": newTestFile("video", "video.mp4")},
+			)
+			require.NoError(t, err)
+
+			_, err = rateCaller.Call(ctx, "https://api.telegram.org/bot1:token/sendVideo", data)
+			require.NoError(t, err)
+			data.CloseBody()
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 2*testRateInterval)
+	})
+
+	t.Run("global", func(t *testing.T) {
+		caller := &testRateCaller{}
+		rateCaller := &RateLimitCaller{
+			Caller:           caller,
+			GlobalLimit:      RateLimit{Count: 1, Per: testRateInterval},
+			PrivateChatLimit: NoRateLimit,
+		}
+
+		start := time.Now()
+		for i := 1; i <= 3; i++ {
+			_, err := rateCaller.Call(ctx, testRateURL, testRateRequest(t, i))
+			require.NoError(t, err)
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 2*testRateInterval)
+	})
+
+	t.Run("canceled_wait_released", func(t *testing.T) {
+		for _, tt := range []struct {
+			name       string
+			rateCaller *RateLimitCaller
+		}{
+			{
+				name: "chat",
+				rateCaller: &RateLimitCaller{
+					GlobalLimit:      NoRateLimit,
+					PrivateChatLimit: RateLimit{Count: 1, Per: 4 * testRateInterval},
+				},
+			},
+			{
+				name: "global",
+				rateCaller: &RateLimitCaller{
+					GlobalLimit:      RateLimit{Count: 1, Per: 4 * testRateInterval},
+					PrivateChatLimit: NoRateLimit,
+				},
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				caller := &testRateCaller{}
+				rateCaller := tt.rateCaller
+				rateCaller.Caller = caller
+
+				start := time.Now()
+				_, err := rateCaller.Call(ctx, testRateURL, testRateRequest(t, 1))
+				require.NoError(t, err)
+
+				cancelCtx, cancel := context.WithTimeout(ctx, testRateInterval)
+				defer cancel()
+
+				_, err = rateCaller.Call(cancelCtx, testRateURL, testRateRequest(t, 1))
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+
+				_, err = rateCaller.Call(ctx, testRateURL, testRateRequest(t, 1))
+				require.NoError(t, err)
+
+				assert.GreaterOrEqual(t, time.Since(start), 4*testRateInterval)
+				assert.Less(t, time.Since(start), 8*testRateInterval)
+				assert.Len(t, caller.calls, 2)
+			})
+		}
+	})
+
+	t.Run("queue_depth", func(t *testing.T) {
+		caller := &testRateCaller{}
+		rateCaller := &RateLimitCaller{
+			Caller:           caller,
+			GlobalLimit:      NoRateLimit,
+			PrivateChatLimit: RateLimit{Count: 1, Per: time.Hour},
+		}
+
+		_, err := rateCaller.Call(ctx, testRateURL, testRateRequest(t, 1))
+		require.NoError(t, err)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			_, errCall := rateCaller.Call(cancelCtx, testRateURL, testRateRequest(t, 1))
+			done <- errCall
+		}()
+
+		assert.Eventually(t, func() bool {
+			return rateCaller.QueueDepth() == 1 && rateCaller.ChatQueueDepth("1") == 1
+		}, time.Second, time.Millisecond)
+		assert.Zero(t, rateCaller.ChatQueueDepth("2"))
+
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+		assert.Zero(t, rateCaller.QueueDepth())
+		assert.Zero(t, rateCaller.ChatQueueDepth("1"))
+	})
+}
+
+func Test_chatIDFromRequest(t *testing.T) {
+	assert.Equal(t, "123", chatIDFromRequest(testRateRequest(t, 123)))
+	assert.Equal(t, "-100123", chatIDFromRequest(testRateRequest(t, -100123)))
+	assert.Equal(t, "@channel", chatIDFromRequest(testRateRequest(t, "@channel")))
+	assert.Equal(t, "", chatIDFromRequest(testRateRequest(t, nil)))
+	assert.Equal(t, "", chatIDFromRequest(testRateRequest(t, true)))
+	assert.Equal(t, "", chatIDFromRequest(nil))
+
+	data, err := DefaultConstructor{}.JSONRequest(map[string]any{"text": "test"})
+	require.NoError(t, err)
+	assert.Equal(t, "", chatIDFromRequest(data))
+
+	data, err = DefaultConstructor{}.MultipartRequest(
+		map[string]string{"chat_id": "456", "caption": "test"},
+		map[string]NamedReader{"photo": newTestFile("photo", "photo.png")},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "456", chatIDFromRequest(data))
+
+	data, err = DefaultConstructor{}.MultipartRequest(map[string]string{"caption": "test"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", chatIDFromRequest(data))
+
+	streamData, err := StreamingConstructor{}.MultipartRequest(map[string]string{"chat_id": "789"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "789", chatIDFromRequest(streamData))
+	streamData.CloseBody()
+
+	assert.Equal(t, "1", chatIDFromRequest(&RequestData{ChatID: "1"}))
+	assert.Equal(t, "", chatIDFromRequest(&RequestData{ContentType: "bad;;", Buffer: data.Buffer}))
+	assert.Equal(t, "", chatIDFromRequest(&RequestData{ContentType: "text/plain", Buffer: data.Buffer}))
+}
+
+func TestIsRateLimitedMethod(t *testing.T) {
+	assert.True(t, IsRateLimitedMethod("sendMessage"))
+	assert.True(t, IsRateLimitedMethod("forwardMessage"))
+	assert.True(t, IsRateLimitedMethod("copyMessages"))
+	assert.False(t, IsRateLimitedMethod("getMe"))
+	assert.False(t, IsRateLimitedMethod("editMessageText"))
+}
+
+func Test_isPrivateChatID(t *testing.T) {
+	assert.True(t, isPrivateChatID("123"))
+	assert.False(t, isPrivateChatID("-123"))
+	assert.False(t, isPrivateChatID("@channel"))
+	assert.False(t, isPrivateChatID(""))
+}
@@ -0,0 +1,269 @@
+package telegoapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"reflect"
+	"sync"
+
+	"github.com/mymmrac/telego/internal/json"
+)
+
+// Recording represents one recorded API call
+type Recording struct {
+	// Method - Name of the API method
+	Method string `json:"method"`
+
+	// Parameters - Request parameters as JSON object, for multipart requests all fields except files are recorded
+	// as strings, empty for streamed requests (see [RequestData.BodyStream]) since their body can be read only once
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+
+	// Files - Names of files sent in multipart request by their field names
+	Files map[string]string `json:"files,omitempty"`
+
+	// Response - Response returned by Telegram
+	Response *Response `json:"response,omitempty"`
+
+	// Error - Error returned by the caller
+	Error string `json:"error,omitempty"`
+}
+
+// newRecording creates recording of request without response
+func newRecording(url string, data *RequestData) (Recording, error) {
+	recording := Recording{
+		Method: methodFromURL(url),
+	}
+	if data == nil || data.Buffer == nil || data.Streaming() {
+		return recording, nil
+	}
+
+	mediaType, params, err := mime.ParseMediaType(data.ContentType)
+	if err != nil {
+		return Recording{}, fmt.Errorf("parse content type: %w", err)
+	}
+
+	switch mediaType {
+	case ContentTypeJSON:
+		if data.Buffer.Len() > 0 {
+			recording.Parameters = bytes.Clone(data.Buffer.Bytes())
+		}
+	case "multipart/form-data":
+		fields, files, err := parseMultipart(data.Buffer.Bytes(), params["boundary"])
+		if err != nil {
+			return Recording{}, fmt.Errorf("parse multipart: %w", err)
+		}
+
+		if len(fields) > 0 {
+			recording.Parameters, err = json.Marshal(fields)
+			if err != nil {
+				return Recording{}, fmt.Errorf("encode parameters: %w", err)
+			}
+		}
+		if len(files) > 0 {
+			recording.Files = files
+		}
+	default:
+		return Recording{}, fmt.Errorf("unsupported content type: %q", mediaType)
+	}
+
+	return recording, nil
+}
+
+// parseMultipart returns fields and file names of multipart body
+func parseMultipart(data []byte, boundary string) (fields, files map[string]string, err error) {
+	fields = make(map[string]string)
+	files = make(map[string]string)
+
+	reader := multipart.NewReader(bytes.NewReader(data), boundary)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return fields, files, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if part.FileName() != "" {
+			files[part.FormName()] = part.FileName()
+			continue
+		}
+
+		value, err := io.ReadAll(part)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields[part.FormName()] = string(value)
+	}
+}
+
+// RecordCaller decorator over Caller that records every call (method, parameters, file names and response) to
+// Writer as JSON lines, recordings can be replayed using [ReplayCaller]
+//
+// Note: Bot token is not recorded, but parameters and responses are recorded as is, so recordings may contain
+// sensitive data.
+// Warning: Zero value is not ready to use, Caller and Writer must be set.
+type RecordCaller struct {
+	Caller Caller
+	Writer io.Writer
+
+	lock sync.Mutex
+}
+
+// Call makes call using provided caller and records it, error is returned if call can't be recorded
+func (r *RecordCaller) Call(ctx context.Context, url string, data *RequestData) (*Response, error) {
+	recording, err := newRecording(url, data)
+	if err != nil {
+		return nil, fmt.Errorf("record: %w", err)
+	}
+
+	resp, callErr := r.Caller.Call(ctx, url, data)
+
+	recording.Response = resp
+	if callErr != nil {
+		recording.Error = callErr.Error()
+	}
+
+	line, err := json.Marshal(recording)
+	if err != nil {
+		return nil, errors.Join(callErr, fmt.Errorf("record: encode: %w", err))
+	}
+
+	r.lock.Lock()
+	_, err = r.Writer.Write(append(line, '\n'))
+	r.lock.Unlock()
+	if err != nil {
+		return nil, errors.Join(callErr, fmt.Errorf("record: write: %w", err))
+	}
+
+	return resp, callErr
+}
+
+// ErrUnexpectedCall returned by [ReplayCaller] when call doesn't match the next recorded call
+var ErrUnexpectedCall = errors.New("unexpected call")
+
+// ReplayCaller implementation of Caller that serves recorded responses (see [RecordCaller]) in the order they were
+// recorded, calls that don't match the next recording (by method, parameters and file names) fail with
+// [ErrUnexpectedCall]
+type ReplayCaller struct {
+	// Recordings - Recorded calls to replay
+	Recordings []Recording
+
+	// IgnoreParameters - Match calls only by method and file names
+	IgnoreParameters bool
+
+	lock sync.Mutex
+	next int
+}
+
+// NewReplayCaller creates replay caller from JSON lines written by [RecordCaller]
+func NewReplayCaller(reader io.Reader) (*ReplayCaller, error) {
+	replay := &ReplayCaller{}
+
+	bufReader := bufio.NewReader(reader)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := bufReader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var recording Recording
+			if decodeErr := json.Unmarshal(line, &recording); decodeErr != nil {
+				return nil, fmt.Errorf("decode recording on line %d: %w", lineNumber, decodeErr)
+			}
+			replay.Recordings = append(replay.Recordings, recording)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return replay, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read recordings: %w", err)
+		}
+	}
+}
+
+// Call returns recorded response if call matches the next recording
+func (r *ReplayCaller) Call(_ context.Context, url string, data *RequestData) (*Response, error) {
+	actual, err := newRecording(url, data)
+	if err != nil {
+		return nil, fmt.Errorf("replay: %w", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.next >= len(r.Recordings) {
+		return nil, fmt.Errorf("%w: %s, all %d recorded calls were replayed", ErrUnexpectedCall,
+			actual.Method, len(r.Recordings))
+	}
+
+	expected := r.Recordings[r.next]
+	if err = r.match(expected, actual); err != nil {
+		return nil, fmt.Errorf("%w: call #%d: %w", ErrUnexpectedCall, r.next+1, err)
+	}
+	r.next++
+
+	if expected.Error != "" {
+		return nil, errors.New(expected.Error)
+	}
+
+	if expected.Response == nil {
+		return nil, nil //nolint:nilnil
+	}
+	resp := *expected.Response
+	return &resp, nil
+}
+
+// match returns error describing difference between expected and actual calls
+func (r *ReplayCaller) match(expected, actual Recording) error {
+	if expected.Method != actual.Method {
+		return fmt.Errorf("expected method %s, got %s", expected.Method, actual.Method)
+	}
+
+	if !r.IgnoreParameters && !equalJSON(expected.Parameters, actual.Parameters) {
+		return fmt.Errorf("%s: expected parameters %s, got %s", actual.Method, expected.Parameters, actual.Parameters)
+	}
+
+	if !maps.Equal(expected.Files, actual.Files) {
+		return fmt.Errorf("%s: expected files %v, got %v", actual.Method, expected.Files, actual.Files)
+	}
+
+	return nil
+}
+
+// Remaining returns number of recorded calls that were not replayed yet
+func (r *ReplayCaller) Remaining() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.Recordings) - r.next
+}
+
+// Done returns error if not all recorded calls were replayed
+func (r *ReplayCaller) Done() error {
+	if remaining := r.Remaining(); remaining > 0 {
+		return fmt.Errorf("%d of %d recorded calls were not replayed", remaining, len(r.Recordings))
+	}
+	return nil
+}
+
+// equalJSON reports whether JSON values are semantically equal
+func equalJSON(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	var aValue, bValue any
+	if err := json.Unmarshal(a, &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
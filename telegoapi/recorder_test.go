@@ -0,0 +1,166 @@
+package telegoapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecordedCaller struct {
+	responses []*Response
+	errs      []error
+	calls     int
+}
+
+func (c *testRecordedCaller) Call(_ context.Context, _ string, _ *RequestData) (*Response, error) {
+	i := c.calls
+	c.calls++
+	return c.responses[i], c.errs[i]
+}
+
+type errorWriter struct{}
+
+func (errorWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("test")
+}
+
+func TestRecordCaller_ReplayCaller(t *testing.T) {
+	ctx := context.Background()
+	constructor := DefaultConstructor{}
+
+	jsonData, err := constructor.JSONRequest(map[string]any{"chat_id": 1, "text": "test"})
+	require.NoError(t, err)
+
+	multipartData, err := constructor.MultipartRequest(map[string]string{"chat_id": "1"}, map[string]NamedReader{
+		"document": newTestFile("Hello World", "file.txt"),
+	})
+	require.NoError(t, err)
+
+	okResp := &Response{Ok: true, Result: []byte(`{"message_id":1}`)}
+	errResp := &Response{Ok: false, Error: &Error{ErrorCode: 400, Description: "Bad Request"}}
+
+	buf := &bytes.Buffer{}
+	recorder := &RecordCaller{
+		Caller: &testRecordedCaller{
+			responses: []*Response{okResp, errResp, nil, okResp},
+			errs:      []error{nil, nil, errors.New("network"), nil},
+		},
+		Writer: buf,
+	}
+
+	resp, err := recorder.Call(ctx, "https://api.telegram.org/bottoken/sendMessage", jsonData)
+	require.NoError(t, err)
+	assert.Equal(t, okResp, resp)
+
+	resp, err = recorder.Call(ctx, "https://api.telegram.org/bottoken/sendDocument", multipartData)
+	require.NoError(t, err)
+	assert.Equal(t, errResp, resp)
+
+	_, err = recorder.Call(ctx, "https://api.telegram.org/bottoken/getMe", &RequestData{})
+	require.Error(t, err)
+
+	_, err = recorder.Call(ctx, "https://api.telegram.org/bottoken/getMe", &RequestData{
+		ContentType: ContentTypeJSON,
+		Buffer:      &bytes.Buffer{},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, strings.Count(buf.String(), "\n"))
+	assert.NotContains(t, buf.String(), "token")
+
+	t.Run("replay", func(t *testing.T) {
+		replay, err := NewReplayCaller(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		require.Len(t, replay.Recordings, 4)
+
+		assert.Equal(t, map[string]string{"document": "file.txt"}, replay.Recordings[1].Files)
+		assert.JSONEq(t, `{"chat_id":"1"}`, string(replay.Recordings[1].Parameters))
+
+		resp, err = replay.Call(ctx, "/bot1/sendMessage", jsonData)
+		require.NoError(t, err)
+		assert.Equal(t, okResp, resp)
+
+		resp, err = replay.Call(ctx, "/bot1/sendDocument", multipartData)
+		require.NoError(t, err)
+		assert.Equal(t, errResp, resp)
+
+		require.Error(t, replay.Done())
+		assert.Equal(t, 2, replay.Remaining())
+
+		_, err = replay.Call(ctx, "/bot1/getMe", &RequestData{})
+		require.EqualError(t, err, "network")
+
+		resp, err = replay.Call(ctx, "/bot1/getMe", &RequestData{})
+		require.NoError(t, err)
+		assert.Equal(t, okResp, resp)
+
+		require.NoError(t, replay.Done())
+
+		_, err = replay.Call(ctx, "/bot1/getMe", &RequestData{})
+		require.ErrorIs(t, err, ErrUnexpectedCall)
+	})
+
+	t.Run("replay_mismatch", func(t *testing.T) {
+		replay, err := NewReplayCaller(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+
+		_, err = replay.Call(ctx, "/bot1/sendDocument", multipartData)
+		require.ErrorIs(t, err, ErrUnexpectedCall)
+
+		otherData, err := constructor.JSONRequest(map[string]any{"chat_id": 2, "text": "test"})
+		require.NoError(t, err)
+
+		_, err = replay.Call(ctx, "/bot1/sendMessage", otherData)
+		require.ErrorIs(t, err, ErrUnexpectedCall)
+
+		replay.IgnoreParameters = true
+		_, err = replay.Call(ctx, "/bot1/sendMessage", otherData)
+		require.NoError(t, err)
+
+		otherFile, err := constructor.MultipartRequest(nil, map[string]NamedReader{
+			"document": newTestFile("Hello World", "other.txt"),
+		})
+		require.NoError(t, err)
+
+		_, err = replay.Call(ctx, "/bot1/sendDocument", otherFile)
+		require.ErrorIs(t, err, ErrUnexpectedCall)
+	})
+}
+
+func TestRecordCaller_Call_errors(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := &RecordCaller{
+		Caller: &testRecordedCaller{responses: []*Response{{Ok: true}}, errs: []error{nil}},
+		Writer: errorWriter{},
+	}
+
+	_, err := recorder.Call(ctx, "/bot1/getMe", &RequestData{ContentType: "text/plain", Buffer: &bytes.Buffer{}})
+	require.Error(t, err)
+
+	_, err = recorder.Call(ctx, "/bot1/getMe", &RequestData{})
+	require.Error(t, err)
+}
+
+func TestNewReplayCaller(t *testing.T) {
+	replay, err := NewReplayCaller(strings.NewReader("\n{\"method\":\"getMe\"}\n\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []Recording{{Method: "getMe"}}, replay.Recordings)
+
+	_, err = NewReplayCaller(strings.NewReader("abc"))
+	require.Error(t, err)
+}
+
+func Test_equalJSON(t *testing.T) {
+	assert.True(t, equalJSON(nil, nil))
+	assert.True(t, equalJSON([]byte(`{"a":1,"b":2}`), []byte(`{"b":2,"a":1}`)))
+	assert.False(t, equalJSON([]byte(`{"a":1}`), nil))
+	assert.False(t, equalJSON([]byte(`{"a":1}`), []byte(`{"a":2}`)))
+	assert.False(t, equalJSON([]byte(`abc`), []byte(`{"a":2}`)))
+	assert.False(t, equalJSON([]byte(`{"a":2}`), []byte(`abc`)))
+}
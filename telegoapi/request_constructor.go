@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/fs"
 	"mime/multipart"
 	"reflect"
 
@@ -25,6 +26,7 @@ func (d DefaultConstructor) JSONRequest(parameters any) (*RequestData, error) {
 	return &RequestData{
 		ContentType: ContentTypeJSON,
 		Buffer:      bytes.NewBuffer(data),
+		ChatID:      chatIDFromJSON(data),
 	}, nil
 }
 
@@ -34,9 +36,94 @@ func (d DefaultConstructor) MultipartRequest(parameters map[string]string, files
 ) {
 	data := &RequestData{
 		Buffer: &bytes.Buffer{},
+		ChatID: parameters[chatIDParameter],
 	}
 	writer := multipart.NewWriter(data.Buffer)
 
+	if err := writeMultipartFiles(writer, filesParameters); err != nil {
+		return nil, err
+	}
+
+	if err := writeMultipartFields(writer, parameters); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("closing writer: %w", err)
+	}
+
+	data.ContentType = writer.FormDataContentType()
+	return data, nil
+}
+
+// UploadProgress represents progress of streamed request upload
+type UploadProgress struct {
+	// Sent - Number of bytes sent
+	Sent int64
+
+	// Total - Total number of bytes to send, negative if unknown
+	Total int64
+}
+
+// StreamingConstructor implementation of RequestConstructor that streams multipart requests instead of copying
+// all files into memory, JSON requests are constructed the same way as by [DefaultConstructor]
+//
+// Note: Streamed request body can be read only once, so such requests are not retried by [RetryCaller]. Size of the
+// body is known only if all files report their size (like [os.File], [bytes.Reader] or [strings.Reader]), otherwise
+// chunked transfer encoding is used.
+type StreamingConstructor struct {
+	// OnProgress - Optional hook that is called each time part of the request body is sent
+	OnProgress func(progress UploadProgress)
+}
+
+// JSONRequest is default implementation
+func (s StreamingConstructor) JSONRequest(parameters any) (*RequestData, error) {
+	return DefaultConstructor{}.JSONRequest(parameters)
+}
+
+// MultipartRequest is streaming implementation, files are read only when request body is sent
+func (s StreamingConstructor) MultipartRequest(parameters map[string]string, filesParameters map[string]NamedReader) (
+	*RequestData, error,
+) {
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+
+	size, err := multipartSize(writer.Boundary(), parameters, filesParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		// Fields are written first, so they can be processed by the server before files are received
+		err := writeMultipartFields(writer, parameters)
+		if err == nil {
+			err = writeMultipartFiles(writer, filesParameters)
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		_ = pipeWriter.CloseWithError(err)
+	}()
+
+	var body io.Reader = pipeReader
+	if s.OnProgress != nil {
+		body = &progressReader{
+			ReadCloser: pipeReader,
+			total:      size,
+			onProgress: s.OnProgress,
+		}
+	}
+
+	return &RequestData{
+		ContentType: writer.FormDataContentType(),
+		BodyStream:  body,
+		BodySize:    size,
+		ChatID:      parameters[chatIDParameter],
+	}, nil
+}
+
+// writeMultipartFiles writes all non-nil files as form files
+func writeMultipartFiles(writer *multipart.Writer, filesParameters map[string]NamedReader) error {
 	for field, file := range filesParameters {
 		if isNil(file) {
 			continue
@@ -44,27 +131,121 @@ func (d DefaultConstructor) MultipartRequest(parameters map[string]string, files
 
 		wr, err := writer.CreateFormFile(field, file.Name())
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		_, err = io.Copy(wr, file)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
+	return nil
+}
+
+// writeMultipartFields writes all parameters as form fields
+func writeMultipartFields(writer *multipart.Writer, parameters map[string]string) error {
 	for field, value := range parameters {
 		if err := writer.WriteField(field, value); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
+	return nil
+}
+
+// multipartSize returns size of multipart body with specified boundary or -1 if size of any file is unknown
+func multipartSize(boundary string, parameters map[string]string, filesParameters map[string]NamedReader) (
+	int64, error,
+) {
+	counter := &countingWriter{}
+	writer := multipart.NewWriter(counter)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return 0, fmt.Errorf("set boundary: %w", err)
+	}
+
+	var filesSize int64
+	for field, file := range filesParameters {
+		if isNil(file) {
+			continue
+		}
+
+		size, ok := ReaderSize(file)
+		if !ok {
+			return -1, nil
+		}
+		filesSize += size
+
+		if _, err := writer.CreateFormFile(field, file.Name()); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := writeMultipartFields(writer, parameters); err != nil {
+		return 0, err
+	}
+
 	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("closing writer: %w", err)
+		return 0, fmt.Errorf("closing writer: %w", err)
 	}
 
-	data.ContentType = writer.FormDataContentType()
-	return data, nil
+	return counter.n + filesSize, nil
+}
+
+// ReaderSize returns number of bytes left to read from reader if it can be determined, size is known for readers
+// that have Len method (like [bytes.Reader] or [strings.Reader]) and regular files (like [os.File])
+func ReaderSize(reader io.Reader) (int64, bool) {
+	switch r := reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case interface {
+		io.Seeker
+		Stat() (fs.FileInfo, error)
+	}:
+		info, err := r.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil || offset > info.Size() {
+			return 0, false
+		}
+
+		return info.Size() - offset, true
+	default:
+		return 0, false
+	}
+}
+
+// countingWriter counts bytes written to it
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// progressReader reports progress of reading
+type progressReader struct {
+	io.ReadCloser
+	sent       int64
+	total      int64
+	onProgress func(progress UploadProgress)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.onProgress(UploadProgress{
+			Sent:  r.sent,
+			Total: r.total,
+		})
+	}
+	return n, err
 }
 
 func isNil(i any) bool {
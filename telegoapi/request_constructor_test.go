@@ -2,9 +2,12 @@ package telegoapi
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"os"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -153,3 +156,119 @@ func Test_isNil(t *testing.T) {
 		})
 	}
 }
+
+var errTestRead = errors.New("test read")
+
+type testSizedFile struct {
+	*strings.Reader
+	fileName string
+}
+
+func (t testSizedFile) Name() string {
+	return t.fileName
+}
+
+func TestStreamingConstructor_JSONRequest(t *testing.T) {
+	data, err := StreamingConstructor{}.JSONRequest(map[string]any{"n": 1})
+	require.NoError(t, err)
+	assert.False(t, data.Streaming())
+	assert.Equal(t, `{"n":1}`, data.Buffer.String())
+}
+
+func TestStreamingConstructor_MultipartRequest(t *testing.T) {
+	t.Run("known_size", func(t *testing.T) {
+		var progress []UploadProgress
+		c := StreamingConstructor{
+			OnProgress: func(p UploadProgress) {
+				progress = append(progress, p)
+			},
+		}
+
+		data, err := c.MultipartRequest(map[string]string{"testParam": "1"}, map[string]NamedReader{
+			"testFile": testSizedFile{Reader: strings.NewReader("Hello World"), fileName: "testF"},
+			"nilFile":  nil,
+		})
+		require.NoError(t, err)
+		require.True(t, data.Streaming())
+		assert.Nil(t, data.Buffer)
+		assert.Contains(t, data.ContentType, "multipart/form-data; boundary=")
+
+		body, err := io.ReadAll(data.BodyStream)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(body), data.BodySize)
+
+		assert.Contains(t, string(body), "Content-Disposition: form-data; name=\"testFile\"; filename=\"testF\"\r\n"+
+			"Content-Type: application/octet-stream\r\n\r\nHello World\r\n")
+		assert.Contains(t, string(body), "Content-Disposition: form-data; name=\"testParam\"\r\n\r\n1\r\n")
+
+		require.NotEmpty(t, progress)
+		assert.Equal(t, UploadProgress{Sent: data.BodySize, Total: data.BodySize}, progress[len(progress)-1])
+	})
+
+	t.Run("os_file", func(t *testing.T) {
+		file, err := os.CreateTemp(t.TempDir(), "test")
+		require.NoError(t, err)
+		defer func() { _ = file.Close() }()
+
+		_, err = file.WriteString("Hello World")
+		require.NoError(t, err)
+		_, err = file.Seek(6, io.SeekStart)
+		require.NoError(t, err)
+
+		data, err := StreamingConstructor{}.MultipartRequest(nil, map[string]NamedReader{"testFile": file})
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(data.BodyStream)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(body), data.BodySize)
+		assert.Contains(t, string(body), "\r\n\r\nWorld\r\n")
+	})
+
+	t.Run("unknown_size", func(t *testing.T) {
+		data, err := StreamingConstructor{}.MultipartRequest(nil, map[string]NamedReader{
+			"testFile": newTestFile("Hello World", "testF"),
+		})
+		require.NoError(t, err)
+		assert.EqualValues(t, -1, data.BodySize)
+
+		body, err := io.ReadAll(data.BodyStream)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Hello World")
+	})
+
+	t.Run("read_error", func(t *testing.T) {
+		data, err := StreamingConstructor{}.MultipartRequest(nil, map[string]NamedReader{
+			"testFile": testFile{data: iotest.ErrReader(errTestRead), fileName: "testF"},
+		})
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(data.BodyStream)
+		require.ErrorIs(t, err, errTestRead)
+	})
+
+	t.Run("close_body", func(t *testing.T) {
+		data, err := StreamingConstructor{}.MultipartRequest(nil, map[string]NamedReader{
+			"testFile": newTestFile("Hello World", "testF"),
+		})
+		require.NoError(t, err)
+
+		data.CloseBody()
+		data.CloseBody()
+
+		_, err = io.ReadAll(data.BodyStream)
+		require.ErrorIs(t, err, io.ErrClosedPipe)
+	})
+}
+
+func TestReaderSize(t *testing.T) {
+	size, ok := ReaderSize(strings.NewReader("test"))
+	assert.True(t, ok)
+	assert.EqualValues(t, 4, size)
+
+	size, ok = ReaderSize(bytes.NewBufferString("test"))
+	assert.True(t, ok)
+	assert.EqualValues(t, 4, size)
+
+	_, ok = ReaderSize(iotest.ErrReader(errTestRead))
+	assert.False(t, ok)
+}
@@ -0,0 +1,126 @@
+package telegoapi
+
+import (
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// RetryAttempt represents information about single call attempt made by [RetryCaller]
+type RetryAttempt struct {
+	// Method - Name of the API method, extracted from request URL
+	Method string
+
+	// Attempt - Number of attempt starting from 1
+	Attempt int
+
+	// Response - Response returned by attempt, may be nil
+	Response *Response
+
+	// Err - Error returned by attempt, may be nil
+	Err error
+
+	// Retry - True if call will be retried
+	Retry bool
+
+	// Delay - Delay before the next attempt, set only if call will be retried
+	Delay time.Duration
+}
+
+// RetryPolicy represents a way to decide if failed call should be retried
+type RetryPolicy interface {
+	// Retry returns true if failed call should be retried, if returned delay is positive it will be used instead of
+	// exponential backoff delay
+	Retry(attempt RetryAttempt) (retry bool, delay time.Duration)
+}
+
+// DefaultRetryPolicy retry policy that retries calls of any method limited by flood control with delay returned by
+// Telegram or failed with error that shows the request was not sent (see [IsRequestNotSentError]), and calls of
+// methods that are safe to retry if they failed with other error (like network error), since it's unknown if the
+// request was executed
+type DefaultRetryPolicy struct {
+	// SendOnce - Reports if method should not be retried after failing with error, [IsSendOnceMethod] used if nil
+	SendOnce func(method string) bool
+}
+
+// Retry returns true if failed call should be retried
+func (p DefaultRetryPolicy) Retry(attempt RetryAttempt) (bool, time.Duration) {
+	if attempt.Err != nil {
+		if IsRequestNotSentError(attempt.Err) {
+			return true, 0
+		}
+
+		sendOnce := p.SendOnce
+		if sendOnce == nil {
+			sendOnce = IsSendOnceMethod
+		}
+		return !sendOnce(attempt.Method), 0
+	}
+
+	if attempt.Response != nil && attempt.Response.Error != nil && attempt.Response.Parameters != nil &&
+		attempt.Response.Parameters.RetryAfter > 0 {
+		return true, time.Duration(attempt.Response.Parameters.RetryAfter) * time.Second
+	}
+
+	return false, 0
+}
+
+// sendOnceMethodPrefixes list of method name prefixes that are not safe to retry, since repeated calls create
+// duplicates (like messages or invite links)
+var sendOnceMethodPrefixes = []string{
+	"send",
+	"forward",
+	"copy",
+	"create",
+	"export",
+	"upload",
+	"add",
+}
+
+// sendOnceMethods list of methods that are not safe to retry, but not covered by [sendOnceMethodPrefixes]
+var sendOnceMethods = []string{
+	"savePreparedInlineMessage",
+	"refundStarPayment",
+}
+
+// IsSendOnceMethod returns true if method is not safe to retry after ambiguous failure, since it may be executed
+// already and repeated call would create a duplicate (for example, sendMessage or createChatInviteLink)
+func IsSendOnceMethod(method string) bool {
+	for _, prefix := range sendOnceMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return slices.Contains(sendOnceMethods, method)
+}
+
+// IsRequestNotSentError returns true if error shows that request was not sent, so it's safe to retry any method,
+// for example, if connection can't be established or host can't be resolved
+func IsRequestNotSentError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	return errors.Is(err, fasthttp.ErrDialTimeout) || errors.Is(err, fasthttp.ErrNoFreeConns)
+}
+
+// methodFromURL returns API method name from request URL
+func methodFromURL(url string) string {
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		url = url[i+1:]
+	}
+	if i := strings.IndexByte(url, '?'); i >= 0 {
+		url = url[:i]
+	}
+	return url
+}
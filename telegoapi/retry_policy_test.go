@@ -0,0 +1,127 @@
+package telegoapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestDefaultRetryPolicy_Retry(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  DefaultRetryPolicy
+		attempt RetryAttempt
+		retry   bool
+		delay   time.Duration
+	}{
+		{
+			name:    "error_safe_method",
+			attempt: RetryAttempt{Method: "getMe", Err: errors.New("test")},
+			retry:   true,
+		},
+		{
+			name:    "error_send_once_method",
+			attempt: RetryAttempt{Method: "sendMessage", Err: errors.New("test")},
+			retry:   false,
+		},
+		{
+			name: "error_send_once_method_not_sent",
+			attempt: RetryAttempt{Method: "sendMessage", Err: fmt.Errorf("fasthttp do request: %w", &net.OpError{
+				Op: "dial", Net: "tcp", Err: errors.New("connection refused"),
+			})},
+			retry: true,
+		},
+		{
+			name: "error_custom_send_once",
+			policy: DefaultRetryPolicy{SendOnce: func(method string) bool {
+				return method == "getMe"
+			}},
+			attempt: RetryAttempt{Method: "getMe", Err: errors.New("test")},
+			retry:   false,
+		},
+		{
+			name: "retry_after",
+			attempt: RetryAttempt{Method: "sendMessage", Response: &Response{
+				Error: &Error{ErrorCode: 429, Parameters: &ResponseParameters{RetryAfter: 3}},
+			}},
+			retry: true,
+			delay: 3 * time.Second,
+		},
+		{
+			name: "api_error",
+			attempt: RetryAttempt{Method: "getMe", Response: &Response{
+				Error: &Error{ErrorCode: 400},
+			}},
+			retry: false,
+		},
+		{
+			name:    "no_error",
+			attempt: RetryAttempt{Method: "getMe", Response: &Response{}},
+			retry:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retry, delay := tt.policy.Retry(tt.attempt)
+			assert.Equal(t, tt.retry, retry)
+			assert.Equal(t, tt.delay, delay)
+		})
+	}
+}
+
+func TestIsSendOnceMethod(t *testing.T) {
+	assert.True(t, IsSendOnceMethod("sendMessage"))
+	assert.True(t, IsSendOnceMethod("forwardMessages"))
+	assert.True(t, IsSendOnceMethod("copyMessage"))
+	assert.True(t, IsSendOnceMethod("createChatInviteLink"))
+	assert.True(t, IsSendOnceMethod("exportChatInviteLink"))
+	assert.True(t, IsSendOnceMethod("savePreparedInlineMessage"))
+	assert.True(t, IsSendOnceMethod("refundStarPayment"))
+
+	assert.False(t, IsSendOnceMethod("getMe"))
+	assert.False(t, IsSendOnceMethod("editMessageText"))
+	assert.False(t, IsSendOnceMethod("deleteMessage"))
+	assert.False(t, IsSendOnceMethod("answerCallbackQuery"))
+}
+
+func Test_methodFromURL(t *testing.T) {
+	assert.Equal(t, "getMe", methodFromURL("https://api.telegram.org/bot1:token/getMe"))
+	assert.Equal(t, "getMe", methodFromURL("https://api.telegram.org/bot1:token/test/getMe"))
+	assert.Equal(t, "getMe", methodFromURL("https://api.telegram.org/bot1:token/getMe?a=b"))
+	assert.Equal(t, "getMe", methodFromURL("getMe"))
+	assert.Equal(t, "", methodFromURL(""))
+}
+
+func TestIsRequestNotSentError(t *testing.T) {
+	assert.True(t, IsRequestNotSentError(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, IsRequestNotSentError(fmt.Errorf("http do request: %w", &net.DNSError{Err: "no such host"})))
+	assert.True(t, IsRequestNotSentError(fmt.Errorf("fasthttp do request: %w", fasthttp.ErrDialTimeout)))
+
+	assert.False(t, IsRequestNotSentError(&net.OpError{Op: "read", Err: errors.New("connection reset")}))
+	assert.False(t, IsRequestNotSentError(errors.New("test")))
+
+	callers := map[string]Caller{
+		"fasthttp": FastHTTPCaller{Client: &fasthttp.Client{}},
+		"http":     HTTPCaller{Client: http.DefaultClient},
+	}
+	for name, caller := range callers {
+		t.Run(name, func(t *testing.T) {
+			_, err := caller.Call(context.Background(), "http://127.0.0.1:1/botTOKEN/sendMessage", &RequestData{
+				ContentType: ContentTypeJSON,
+				Buffer:      bytes.NewBufferString("{}"),
+			})
+			require.Error(t, err)
+			assert.True(t, IsRequestNotSentError(err))
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package telegobroadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mymmrac/telego"
+	"github.com/mymmrac/telego/internal/ratelimit"
+	"github.com/mymmrac/telego/internal/watermark"
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+const (
+	// defaultWorkers default number of concurrent senders
+	defaultWorkers = 8
+
+	// defaultFloodRetries default number of retries after hitting flood control
+	defaultFloodRetries = 3
+
+	// defaultCheckpointInterval default number of processed recipients between checkpoint saves
+	defaultCheckpointInterval = 100
+)
+
+// Broadcaster sends messages to large number of recipients
+type Broadcaster struct {
+	bot                *telego.Bot
+	rateLimit          ta.RateLimit
+	workers            int
+	floodRetries       int
+	checkpoints        CheckpointStore
+	checkpointInterval int
+	onOutcome          func(outcome Outcome)
+}
+
+// BroadcasterOption represents an option that can be applied to Broadcaster
+type BroadcasterOption func(b *Broadcaster) error
+
+// WithRateLimit sets limit of messages sent by broadcast, [ta.NoRateLimit] can be used if bot's caller already
+// applies rate limits (like [ta.RateLimitCaller]).
+// Default is [ta.DefaultGlobalRateLimit].
+func WithRateLimit(limit ta.RateLimit) BroadcasterOption {
+	return func(b *Broadcaster) error {
+		if limit.Count > 0 && limit.Per <= 0 {
+			return fmt.Errorf("rate limit period is not positive: %s", limit.Per)
+		}
+
+		b.rateLimit = limit
+		return nil
+	}
+}
+
+// WithWorkers sets number of messages sent concurrently.
+// Default is 8.
+func WithWorkers(workers int) BroadcasterOption {
+	return func(b *Broadcaster) error {
+		if workers < 1 {
+			return fmt.Errorf("workers should be positive: %d", workers)
+		}
+
+		b.workers = workers
+		return nil
+	}
+}
+
+// WithFloodRetries sets number of times sending to recipient is retried after hitting flood control, all workers
+// wait for the time requested by Telegram before sending next message.
+// Default is 3.
+func WithFloodRetries(retries int) BroadcasterOption {
+	return func(b *Broadcaster) error {
+		if retries < 0 {
+			return fmt.Errorf("flood retries are negative: %d", retries)
+		}
+
+		b.floodRetries = retries
+		return nil
+	}
+}
+
+// WithCheckpointStore sets storage of checkpoints used to resume interrupted broadcasts
+func WithCheckpointStore(store CheckpointStore) BroadcasterOption {
+	return func(b *Broadcaster) error {
+		if store == nil {
+			return errors.New("checkpoint store can't be nil")
+		}
+
+		b.checkpoints = store
+		return nil
+	}
+}
+
+// WithCheckpointInterval sets number of processed recipients between checkpoint saves, checkpoint is always saved
+// when broadcast run finishes.
+// Default is 100.
+func WithCheckpointInterval(interval int) BroadcasterOption {
+	return func(b *Broadcaster) error {
+		if interval < 1 {
+			return fmt.Errorf("checkpoint interval should be positive: %d", interval)
+		}
+
+		b.checkpointInterval = interval
+		return nil
+	}
+}
+
+// WithOnOutcome sets hook that is called with delivery outcome of each recipient as soon as it's known
+// Note: Hook is called sequentially, but not in recipients order
+func WithOnOutcome(onOutcome func(outcome Outcome)) BroadcasterOption {
+	return func(b *Broadcaster) error {
+		if onOutcome == nil {
+			return errors.New("outcome hook can't be nil")
+		}
+
+		b.onOutcome = onOutcome
+		return nil
+	}
+}
+
+// New creates new broadcaster with given options
+func New(bot *telego.Bot, options ...BroadcasterOption) (*Broadcaster, error) {
+	if bot == nil {
+		return nil, errors.New("telego: broadcast: bot can't be nil")
+	}
+
+	b := &Broadcaster{
+		bot:                bot,
+		rateLimit:          ta.DefaultGlobalRateLimit,
+		workers:            defaultWorkers,
+		floodRetries:       defaultFloodRetries,
+		checkpointInterval: defaultCheckpointInterval,
+	}
+
+	for _, option := range options {
+		if err := option(b); err != nil {
+			return nil, fmt.Errorf("telego: broadcast options: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+// broadcastJob represents message delivery to one recipient
+type broadcastJob struct {
+	index  int
+	chatID telego.ChatID
+}
+
+// Run sends message template to all recipients and returns delivery report, the report is returned even if
+// broadcast was interrupted by error or context cancellation. If checkpoint store is set, ID is used to resume
+// previous runs of the same broadcast, recipients processed by them are skipped.
+func (b *Broadcaster) Run(ctx context.Context, id string, recipients Recipients, template Template) (*Report, error) {
+	if recipients == nil || template == nil {
+		return nil, errors.New("telego: broadcast: recipients and template can't be nil")
+	}
+
+	processed := 0
+	if b.checkpoints != nil {
+		var err error
+		processed, err = b.checkpoints.Load(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("telego: broadcast: load checkpoint: %w", err)
+		}
+	}
+
+	report := &Report{
+		ID:      id,
+		Started: time.Now(),
+	}
+
+	for report.Resumed < processed {
+		_, ok, err := recipients.Next(ctx)
+		if err != nil {
+			report.Finished = time.Now()
+			return report, fmt.Errorf("telego: broadcast: recipients: %w", err)
+		}
+		if !ok {
+			break
+		}
+		report.Resumed++
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan broadcastJob)
+	outcomes := make(chan Outcome)
+
+	tracker := &watermark.Tracker{}
+	var recipientsErr error
+	go func() {
+		defer close(jobs)
+
+		for index := processed; ; index++ {
+			chatID, ok, err := recipients.Next(runCtx)
+			if err != nil {
+				recipientsErr = err
+				return
+			}
+			if !ok {
+				return
+			}
+
+			tracker.Add(index)
+			select {
+			case jobs <- broadcastJob{index: index, chatID: chatID}:
+			case <-runCtx.Done():
+				return
+			}
+		}
+	}()
+
+	limiter := newRateLimiter(b.rateLimit)
+	wg := sync.WaitGroup{}
+	wg.Add(b.workers)
+	for i := 0; i < b.workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for job := range jobs {
+				if outcome, ok := b.deliver(runCtx, limiter, template, job); ok {
+					outcomes <- outcome
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	checkpoint := processed
+	sinceSave := 0
+	var checkpointErr error
+
+	for outcome := range outcomes {
+		report.Outcomes = append(report.Outcomes, outcome)
+		if b.onOutcome != nil {
+			b.onOutcome(outcome)
+		}
+
+		if last, ok := tracker.Done(outcome.Index); ok {
+			checkpoint = last + 1
+		}
+		sinceSave++
+
+		if b.checkpoints != nil && sinceSave >= b.checkpointInterval && checkpointErr == nil {
+			sinceSave = 0
+			if err := b.checkpoints.Save(runCtx, id, checkpoint); err != nil {
+				checkpointErr = fmt.Errorf("save checkpoint: %w", err)
+				cancel()
+			}
+		}
+	}
+
+	if b.checkpoints != nil && checkpointErr == nil {
+		if err := b.checkpoints.Save(context.WithoutCancel(ctx), id, checkpoint); err != nil {
+			checkpointErr = fmt.Errorf("save checkpoint: %w", err)
+		}
+	}
+
+	sort.Slice(report.Outcomes, func(i, j int) bool {
+		return report.Outcomes[i].Index < report.Outcomes[j].Index
+	})
+	report.Finished = time.Now()
+
+	if recipientsErr != nil {
+		recipientsErr = fmt.Errorf("recipients: %w", recipientsErr)
+	}
+	if err := errors.Join(ctx.Err(), recipientsErr, checkpointErr); err != nil {
+		return report, fmt.Errorf("telego: broadcast: %w", err)
+	}
+
+	return report, nil
+}
+
+// deliver sends message to recipient retrying after flood control, returns false if delivery was interrupted by
+// context cancellation
+func (b *Broadcaster) deliver(ctx context.Context, limiter *rateLimiter, template Template, job broadcastJob) (
+	Outcome, bool,
+) {
+	outcome := Outcome{
+		Index:  job.index,
+		ChatID: job.chatID,
+	}
+
+	for {
+		if err := limiter.wait(ctx); err != nil {
+			return outcome, false
+		}
+
+		outcome.Attempts++
+		messageID, err := template(ctx, b.bot, job.chatID)
+		if err == nil {
+			outcome.Status = StatusSent
+			outcome.MessageID = messageID
+			return outcome, true
+		}
+
+		if ctx.Err() != nil {
+			return outcome, false
+		}
+
+		if retryAfter, ok := ta.RetryAfter(err); ok && outcome.Attempts <= b.floodRetries {
+			limiter.pause(retryAfter)
+			continue
+		}
+
+		outcome.Status = statusOf(err)
+		outcome.Err = err
+		return outcome, true
+	}
+}
+
+// rateLimiter paces messages sent by all workers
+type rateLimiter struct {
+	lock        sync.Mutex
+	schedule    ratelimit.Schedule
+	pausedUntil time.Time
+}
+
+// newRateLimiter creates rate limiter from rate limit
+func newRateLimiter(limit ta.RateLimit) *rateLimiter {
+	l := &rateLimiter{}
+	l.schedule.SetLimit(limit.Count, limit.Per, limit.Burst)
+	return l
+}
+
+// wait blocks until the next message is allowed to be sent
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.lock.Lock()
+	allowAt := l.schedule.Reserve(time.Now()).AllowAt
+	if allowAt.Before(l.pausedUntil) {
+		allowAt = l.pausedUntil
+	}
+	l.lock.Unlock()
+
+	return ratelimit.SleepUntil(ctx, allowAt)
+}
+
+// pause stops sending messages for the specified duration
+func (l *rateLimiter) pause(duration time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	pausedUntil := time.Now().Add(duration)
+	if l.pausedUntil.Before(pausedUntil) {
+		l.pausedUntil = pausedUntil
+	}
+}
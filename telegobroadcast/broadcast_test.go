@@ -0,0 +1,259 @@
+package telegobroadcast
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fastjson"
+
+	"github.com/mymmrac/telego"
+	ta "github.com/mymmrac/telego/telegoapi"
+	tu "github.com/mymmrac/telego/telegoutil"
+)
+
+const testToken = "1234567890:aaaabbbbaaaabbbbaaaabbbbaaaabbbbccc"
+
+const (
+	chatBlocked     = 1001
+	chatDeactivated = 1002
+	chatFlood       = 1003
+	chatFailed      = 1004
+)
+
+type testCaller struct {
+	lock    sync.Mutex
+	sent    []int64
+	flooded bool
+}
+
+func (c *testCaller) Call(_ context.Context, _ string, data *ta.RequestData) (*ta.Response, error) {
+	value, err := fastjson.ParseBytes(data.Buffer.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	chatID := value.GetInt64("chat_id")
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	switch chatID {
+	case chatBlocked:
+		return &ta.Response{Error: &ta.Error{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"}}, nil
+	case chatDeactivated:
+		return &ta.Response{Error: &ta.Error{ErrorCode: 403, Description: "Forbidden: user is deactivated"}}, nil
+	case chatFlood:
+		if !c.flooded {
+			c.flooded = true
+			return &ta.Response{Error: &ta.Error{
+				ErrorCode:   429,
+				Description: "Too Many Requests: retry after 1",
+				Parameters:  &ta.ResponseParameters{RetryAfter: 1},
+			}}, nil
+		}
+	case chatFailed:
+		return nil, errors.New("test")
+	}
+
+	c.sent = append(c.sent, chatID)
+	return &ta.Response{Ok: true, Result: []byte(`{"message_id":` + strconv.Itoa(len(c.sent)) + `}`)}, nil
+}
+
+func newTestBot(t *testing.T) (*telego.Bot, *testCaller) {
+	t.Helper()
+
+	caller := &testCaller{}
+	bot, err := telego.NewBot(testToken, telego.WithAPICaller(caller), telego.WithDiscardLogger())
+	require.NoError(t, err)
+
+	return bot, caller
+}
+
+func TestBroadcaster_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		bot, caller := newTestBot(t)
+
+		var outcomes []Outcome
+		broadcaster, err := New(bot,
+			WithRateLimit(ta.NoRateLimit),
+			WithWorkers(2),
+			WithOnOutcome(func(outcome Outcome) {
+				outcomes = append(outcomes, outcome)
+			}),
+		)
+		require.NoError(t, err)
+
+		report, err := broadcaster.Run(ctx, "test", IDs(1, chatBlocked, 2, chatDeactivated, chatFlood, chatFailed),
+			SendMessage(tu.Message(telego.ChatID{}, "test")))
+		require.NoError(t, err)
+
+		require.Len(t, report.Outcomes, 6)
+		assert.Len(t, outcomes, 6)
+		for i, outcome := range report.Outcomes {
+			assert.Equal(t, i, outcome.Index)
+		}
+
+		assert.Equal(t, 3, report.Count(StatusSent))
+		assert.Equal(t, []telego.ChatID{tu.ID(chatBlocked)}, report.ChatIDs(StatusBlocked))
+		assert.Equal(t, []telego.ChatID{tu.ID(chatDeactivated)}, report.ChatIDs(StatusDeactivated))
+		assert.Equal(t, []telego.ChatID{tu.ID(chatFailed)}, report.ChatIDs(StatusFailed))
+
+		flood := report.Outcomes[4]
+		assert.Equal(t, StatusSent, flood.Status)
+		assert.Equal(t, 2, flood.Attempts)
+		assert.NotZero(t, flood.MessageID)
+
+		assert.ElementsMatch(t, []int64{1, 2, chatFlood}, caller.sent)
+		assert.False(t, report.Finished.Before(report.Started))
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		bot, caller := newTestBot(t)
+		store := &MemoryCheckpointStore{}
+		require.NoError(t, store.Save(ctx, "test", 2))
+
+		broadcaster, err := New(bot, WithRateLimit(ta.NoRateLimit), WithCheckpointStore(store),
+			WithCheckpointInterval(1))
+		require.NoError(t, err)
+
+		report, err := broadcaster.Run(ctx, "test", IDs(1, 2, 3, 4),
+			CopyMessage(tu.CopyMessage(telego.ChatID{}, tu.ID(1), 1)))
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, report.Resumed)
+		assert.Len(t, report.Outcomes, 2)
+		assert.ElementsMatch(t, []int64{3, 4}, caller.sent)
+
+		processed, err := store.Load(ctx, "test")
+		require.NoError(t, err)
+		assert.Equal(t, 4, processed)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		bot, _ := newTestBot(t)
+		store := &MemoryCheckpointStore{}
+
+		broadcaster, err := New(bot, WithRateLimit(ta.RateLimit{Count: 1, Per: time.Hour}), WithWorkers(1),
+			WithCheckpointStore(store))
+		require.NoError(t, err)
+
+		canceledCtx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+		defer cancel()
+
+		report, err := broadcaster.Run(canceledCtx, "test", IDs(1, 2, 3), SendMessage(tu.Message(telego.ChatID{}, "")))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.NotNil(t, report)
+		assert.Len(t, report.Outcomes, 1)
+
+		processed, err := store.Load(ctx, "test")
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("error_recipients", func(t *testing.T) {
+		bot, _ := newTestBot(t)
+
+		broadcaster, err := New(bot, WithRateLimit(ta.NoRateLimit))
+		require.NoError(t, err)
+
+		calls := 0
+		recipients := RecipientsFunc(func(_ context.Context) (telego.ChatID, bool, error) {
+			calls++
+			if calls > 1 {
+				return telego.ChatID{}, false, errors.New("test")
+			}
+			return tu.ID(1), true, nil
+		})
+
+		report, err := broadcaster.Run(ctx, "test", recipients, SendMessage(tu.Message(telego.ChatID{}, "")))
+		require.Error(t, err)
+		assert.Len(t, report.Outcomes, 1)
+	})
+
+	t.Run("error_nil", func(t *testing.T) {
+		bot, _ := newTestBot(t)
+
+		broadcaster, err := New(bot)
+		require.NoError(t, err)
+
+		_, err = broadcaster.Run(ctx, "test", nil, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestNew(t *testing.T) {
+	bot, _ := newTestBot(t)
+
+	_, err := New(nil)
+	require.Error(t, err)
+
+	options := []BroadcasterOption{
+		WithRateLimit(ta.RateLimit{Count: 1}),
+		WithWorkers(0),
+		WithFloodRetries(-1),
+		WithCheckpointStore(nil),
+		WithCheckpointInterval(0),
+		WithOnOutcome(nil),
+	}
+	for _, option := range options {
+		_, err = New(bot, option)
+		require.Error(t, err)
+	}
+
+	broadcaster, err := New(bot, WithFloodRetries(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, broadcaster.floodRetries)
+	assert.Equal(t, ta.DefaultGlobalRateLimit, broadcaster.rateLimit)
+}
+
+func Test_rateLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := newRateLimiter(ta.RateLimit{Count: 10, Per: time.Second, Burst: 2})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.wait(ctx))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*90)
+
+	limiter.pause(time.Millisecond * 50)
+	start = time.Now()
+	require.NoError(t, limiter.wait(ctx))
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*40)
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	limiter.pause(time.Hour)
+	require.ErrorIs(t, limiter.wait(canceledCtx), context.Canceled)
+}
+
+func TestRecipients(t *testing.T) {
+	ctx := context.Background()
+	recipients := IDs(1, 2)
+
+	chatID, ok, err := recipients.Next(ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, tu.ID(1), chatID)
+
+	_, ok, _ = recipients.Next(ctx)
+	assert.True(t, ok)
+
+	_, ok, err = recipients.Next(ctx)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func Test_statusOf(t *testing.T) {
+	assert.Equal(t, StatusBlocked, statusOf(&ta.Error{ErrorCode: 403, Description: "Forbidden: bot was kicked"}))
+	assert.Equal(t, StatusForbidden, statusOf(&ta.Error{ErrorCode: 403, Description: "Forbidden"}))
+	assert.Equal(t, StatusNotFound, statusOf(&ta.Error{ErrorCode: 400, Description: "Bad Request: chat not found"}))
+	assert.Equal(t, StatusFailed, statusOf(errors.New("test")))
+}
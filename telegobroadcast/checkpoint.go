@@ -0,0 +1,105 @@
+package telegobroadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mymmrac/telego/internal/atomicfile"
+)
+
+// CheckpointStore represents storage of broadcast checkpoints
+type CheckpointStore interface {
+	// Load returns number of recipients processed by previous runs of broadcast, zero if there is no checkpoint
+	Load(ctx context.Context, id string) (int, error)
+
+	// Save stores number of recipients processed by broadcast
+	Save(ctx context.Context, id string, processed int) error
+}
+
+// MemoryCheckpointStore in-memory implementation of [CheckpointStore], useful for resuming canceled broadcasts in
+// the same process
+// Note: Zero value is ready to use
+type MemoryCheckpointStore struct {
+	lock        sync.RWMutex
+	checkpoints map[string]int
+}
+
+// Load returns checkpoint from memory
+func (s *MemoryCheckpointStore) Load(_ context.Context, id string) (int, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.checkpoints[id], nil
+}
+
+// Save stores checkpoint in memory
+func (s *MemoryCheckpointStore) Save(_ context.Context, id string, processed int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.checkpoints == nil {
+		s.checkpoints = make(map[string]int)
+	}
+	s.checkpoints[id] = processed
+	return nil
+}
+
+// FileCheckpointStore file implementation of [CheckpointStore], each checkpoint is stored in a separate file named
+// after broadcast ID (<id>.checkpoint) in Dir
+type FileCheckpointStore struct {
+	// Dir - Directory where checkpoints are stored, must exist
+	Dir string
+}
+
+// checkpointExt extension of checkpoint files
+const checkpointExt = ".checkpoint"
+
+// Load reads checkpoint from file
+func (s *FileCheckpointStore) Load(_ context.Context, id string) (int, error) {
+	path, err := s.path(id)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("read checkpoint: %w", err)
+	}
+
+	processed, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("parse checkpoint: %w", err)
+	}
+
+	return processed, nil
+}
+
+// Save atomically writes checkpoint to file
+func (s *FileCheckpointStore) Save(_ context.Context, id string, processed int) error {
+	path, err := s.path(id)
+	if err != nil {
+		return err
+	}
+
+	if err = atomicfile.WriteFile(path, []byte(strconv.Itoa(processed))); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// path returns path to checkpoint file
+func (s *FileCheckpointStore) path(id string) (string, error) {
+	if id == "" || id == "." || id == ".." || strings.ContainsAny(id, `/\`) {
+		return "", fmt.Errorf("invalid broadcast ID: %q", id)
+	}
+	return filepath.Join(s.Dir, id+checkpointExt), nil
+}
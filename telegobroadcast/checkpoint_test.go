@@ -0,0 +1,66 @@
+package telegobroadcast
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	store := &MemoryCheckpointStore{}
+
+	processed, err := store.Load(ctx, "test")
+	require.NoError(t, err)
+	assert.Zero(t, processed)
+
+	require.NoError(t, store.Save(ctx, "test", 10))
+
+	processed, err = store.Load(ctx, "test")
+	require.NoError(t, err)
+	assert.Equal(t, 10, processed)
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	store := &FileCheckpointStore{Dir: t.TempDir()}
+
+	processed, err := store.Load(ctx, "test")
+	require.NoError(t, err)
+	assert.Zero(t, processed)
+
+	require.NoError(t, store.Save(ctx, "test", 10))
+	require.NoError(t, store.Save(ctx, "test", 20))
+
+	processed, err = store.Load(ctx, "test")
+	require.NoError(t, err)
+	assert.Equal(t, 20, processed)
+
+	entries, err := os.ReadDir(store.Dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	t.Run("error_id", func(t *testing.T) {
+		for _, id := range []string{"", ".", "..", "a/b", `a\b`} {
+			_, err = store.Load(ctx, id)
+			require.Error(t, err)
+			require.Error(t, store.Save(ctx, id, 1))
+		}
+	})
+
+	t.Run("error_parse", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(store.Dir, "bad"+checkpointExt), []byte("abc"), 0o600))
+
+		_, err = store.Load(ctx, "bad")
+		require.Error(t, err)
+	})
+
+	t.Run("error_dir", func(t *testing.T) {
+		badStore := &FileCheckpointStore{Dir: filepath.Join(store.Dir, "missing")}
+		require.Error(t, badStore.Save(ctx, "test", 1))
+	})
+}
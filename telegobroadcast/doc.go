@@ -0,0 +1,25 @@
+/*
+Package telegobroadcast provides a way to send messages to large audiences with Telego.
+
+Broadcaster sends message template to every recipient of the iterator respecting rate limits (including flood control
+waits reported by Telegram), skips recipients who blocked the bot or deactivated their accounts, periodically saves
+checkpoint so interrupted broadcast can be resumed, and produces a report with delivery outcome of every recipient.
+
+	broadcaster, _ := telegobroadcast.New(bot,
+		telegobroadcast.WithCheckpointStore(&telegobroadcast.FileCheckpointStore{Dir: "checkpoints"}),
+	)
+
+	report, err := broadcaster.Run(ctx, "announcement", telegobroadcast.IDs(userIDs...),
+		telegobroadcast.SendMessage(tu.Message(telego.ChatID{}, "Hello everyone!")),
+	)
+	if err != nil {
+		// Broadcast was interrupted, run it again with the same ID to resume
+	}
+
+	blocked := report.ChatIDs(telegobroadcast.StatusBlocked)
+
+Note: Delivery is at-least-once, if broadcast is interrupted, recipients processed after the last saved checkpoint
+will receive the message again when broadcast is resumed. Recipients must be iterated in the same order on each run
+of the broadcast with the same ID.
+*/
+package telegobroadcast
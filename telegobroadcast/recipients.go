@@ -0,0 +1,52 @@
+package telegobroadcast
+
+import (
+	"context"
+
+	"github.com/mymmrac/telego"
+	tu "github.com/mymmrac/telego/telegoutil"
+)
+
+// Recipients represents iterator over broadcast recipients
+type Recipients interface {
+	// Next returns next recipient or false if there are no more recipients
+	Next(ctx context.Context) (telego.ChatID, bool, error)
+}
+
+// RecipientsFunc represents function that implements [Recipients]
+type RecipientsFunc func(ctx context.Context) (telego.ChatID, bool, error)
+
+// Next calls function
+func (f RecipientsFunc) Next(ctx context.Context) (telego.ChatID, bool, error) {
+	return f(ctx)
+}
+
+// sliceRecipients iterator over slice of chat IDs
+type sliceRecipients struct {
+	chatIDs []telego.ChatID
+	index   int
+}
+
+func (r *sliceRecipients) Next(_ context.Context) (telego.ChatID, bool, error) {
+	if r.index >= len(r.chatIDs) {
+		return telego.ChatID{}, false, nil
+	}
+
+	chatID := r.chatIDs[r.index]
+	r.index++
+	return chatID, true, nil
+}
+
+// Slice returns recipients iterator over chat IDs
+func Slice(chatIDs []telego.ChatID) Recipients {
+	return &sliceRecipients{chatIDs: chatIDs}
+}
+
+// IDs returns recipients iterator over chat IDs
+func IDs(ids ...int64) Recipients {
+	chatIDs := make([]telego.ChatID, len(ids))
+	for i, id := range ids {
+		chatIDs[i] = tu.ID(id)
+	}
+	return Slice(chatIDs)
+}
@@ -0,0 +1,110 @@
+package telegobroadcast
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mymmrac/telego"
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+// Status represents delivery status of message to recipient
+type Status string
+
+// Delivery statuses
+const (
+	// StatusSent - Message was sent
+	StatusSent Status = "sent"
+
+	// StatusBlocked - Recipient blocked the bot or bot was kicked from the chat
+	StatusBlocked Status = "blocked"
+
+	// StatusDeactivated - Recipient's account is deactivated
+	StatusDeactivated Status = "deactivated"
+
+	// StatusForbidden - Bot has no rights to send messages to recipient for other reasons
+	StatusForbidden Status = "forbidden"
+
+	// StatusNotFound - Recipient's chat not found
+	StatusNotFound Status = "not_found"
+
+	// StatusFailed - Message wasn't sent because of other error
+	StatusFailed Status = "failed"
+)
+
+// statusOf returns delivery status of failed send
+func statusOf(err error) Status {
+	switch {
+	case errors.Is(err, ta.ErrBotBlocked), errors.Is(err, ta.ErrBotKicked):
+		return StatusBlocked
+	case errors.Is(err, ta.ErrUserDeactivated):
+		return StatusDeactivated
+	case errors.Is(err, ta.ErrForbidden):
+		return StatusForbidden
+	case errors.Is(err, ta.ErrChatNotFound):
+		return StatusNotFound
+	default:
+		return StatusFailed
+	}
+}
+
+// Outcome represents result of message delivery to one recipient
+type Outcome struct {
+	// Index - Position of recipient in recipients iterator
+	Index int
+
+	// ChatID - Recipient's chat ID
+	ChatID telego.ChatID
+
+	// Status - Delivery status
+	Status Status
+
+	// MessageID - ID of sent message, set only if message was sent
+	MessageID int
+
+	// Attempts - Number of send attempts (more than one if flood control was hit)
+	Attempts int
+
+	// Err - Error of the last send attempt, set only if message wasn't sent
+	Err error
+}
+
+// Report represents delivery report of broadcast run
+type Report struct {
+	// ID - Broadcast ID
+	ID string
+
+	// Resumed - Number of recipients skipped, because they were processed by previous runs of the broadcast
+	Resumed int
+
+	// Outcomes - Delivery outcomes of recipients processed in this run ordered by recipient index
+	Outcomes []Outcome
+
+	// Started - Time when run started
+	Started time.Time
+
+	// Finished - Time when run finished
+	Finished time.Time
+}
+
+// Count returns number of recipients with specified delivery status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, outcome := range r.Outcomes {
+		if outcome.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// ChatIDs returns chat IDs of recipients with specified delivery status
+func (r *Report) ChatIDs(status Status) []telego.ChatID {
+	var chatIDs []telego.ChatID
+	for _, outcome := range r.Outcomes {
+		if outcome.Status == status {
+			chatIDs = append(chatIDs, outcome.ChatID)
+		}
+	}
+	return chatIDs
+}
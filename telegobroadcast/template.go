@@ -0,0 +1,40 @@
+package telegobroadcast
+
+import (
+	"context"
+
+	"github.com/mymmrac/telego"
+)
+
+// Template represents message that is sent to each recipient, it returns ID of sent message
+type Template func(ctx context.Context, bot *telego.Bot, chatID telego.ChatID) (messageID int, err error)
+
+// SendMessage returns template that sends message with specified parameters, chat ID of parameters is replaced with
+// recipient's chat ID
+func SendMessage(params *telego.SendMessageParams) Template {
+	return func(ctx context.Context, bot *telego.Bot, chatID telego.ChatID) (int, error) {
+		recipientParams := *params
+		recipientParams.ChatID = chatID
+
+		msg, err := bot.SendMessage(ctx, &recipientParams)
+		if err != nil {
+			return 0, err
+		}
+		return msg.MessageID, nil
+	}
+}
+
+// CopyMessage returns template that copies message with specified parameters, chat ID of parameters is replaced with
+// recipient's chat ID
+func CopyMessage(params *telego.CopyMessageParams) Template {
+	return func(ctx context.Context, bot *telego.Bot, chatID telego.ChatID) (int, error) {
+		recipientParams := *params
+		recipientParams.ChatID = chatID
+
+		msgID, err := bot.CopyMessage(ctx, &recipientParams)
+		if err != nil {
+			return 0, err
+		}
+		return msgID.MessageID, nil
+	}
+}
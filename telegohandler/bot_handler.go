@@ -56,7 +56,8 @@ func NewBotHandler(bot *telego.Bot, updates <-chan telego.Update, options ...Bot
 	return bh, nil
 }
 
-// Start starts handling of updates, blocks execution
+// Start starts handling of updates, blocks execution, handled updates are marked as processed using
+// [telego.Update.Done] method
 // Note: Calling [BotHandler.Start] method multiple times after the first one does nothing.
 func (h *BotHandler) Start() {
 	h.runningLock.RLock()
@@ -98,6 +99,11 @@ func (h *BotHandler) Start() {
 				}()
 
 				h.baseGroup.processUpdate(h.bot, update.WithContext(ctx))
+
+				// Updates interrupted by stop are not marked as processed
+				if ctx.Err() == nil {
+					update.Done()
+				}
 				cancel()
 
 				h.handledUpdates.Done()
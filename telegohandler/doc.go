@@ -49,7 +49,7 @@ impacts order of checking matched handlers, and only the first matched handler w
 		// Register new handler with match on command `/start`
 		bh.Handle(func(bot *telego.Bot, update telego.Update) {
 			// Send message
-			_, _ = bot.SendMessage(tu.Messagef(
+			_, _ = bot.SendMessage(update.Context(), tu.Messagef(
 				tu.ID(update.Message.Chat.ID),
 				"Hello %s!", update.Message.From.FirstName,
 			))
@@ -60,7 +60,7 @@ impacts order of checking matched handlers, and only the first matched handler w
 		// except `/start` command
 		bh.Handle(func(bot *telego.Bot, update telego.Update) {
 			// Send message
-			_, _ = bot.SendMessage(tu.Message(
+			_, _ = bot.SendMessage(update.Context(), tu.Message(
 				tu.ID(update.Message.Chat.ID),
 				"Unknown command, use /start",
 			))
@@ -75,6 +75,7 @@ One more example of handler usage. It shows how to use specific handlers to proc
 	package main
 
 	import (
+		"context"
 		"fmt"
 		"os"
 
@@ -106,9 +107,9 @@ One more example of handler usage. It shows how to use specific handlers to proc
 		defer bot.StopLongPolling()
 
 		// Register new handler with match on command `/start`
-		bh.HandleMessage(func(bot *telego.Bot, message telego.Message) {
+		bh.HandleMessageCtx(func(ctx context.Context, bot *telego.Bot, message telego.Message) {
 			// Send a message with inline keyboard
-			_, _ = bot.SendMessage(tu.Messagef(
+			_, _ = bot.SendMessage(ctx, tu.Messagef(
 				tu.ID(message.Chat.ID),
 				"Hello %s!", message.From.FirstName,
 			).WithReplyMarkup(tu.InlineKeyboard(
@@ -117,12 +118,12 @@ One more example of handler usage. It shows how to use specific handlers to proc
 		}, th.CommandEqual("start"))
 
 		// Register new handler with match on a call back query with data equal to `go` and non-nil message
-		bh.HandleCallbackQuery(func(bot *telego.Bot, query telego.CallbackQuery) {
+		bh.HandleCallbackQueryCtx(func(ctx context.Context, bot *telego.Bot, query telego.CallbackQuery) {
 			// Send message
-			_, _ = bot.SendMessage(tu.Message(tu.ID(query.Message.Chat.ID), "GO GO GO"))
+			_, _ = bot.SendMessage(ctx, tu.Message(tu.ID(query.Message.GetChat().ID), "GO GO GO"))
 
 			// Answer callback query
-			_ = bot.AnswerCallbackQuery(tu.CallbackQuery(query.ID).WithText("Done"))
+			_ = bot.AnswerCallbackQuery(ctx, tu.CallbackQuery(query.ID).WithText("Done"))
 		}, th.AnyCallbackQueryWithMessage(), th.CallbackDataEqual("go"))
 
 		// Start handling updates
@@ -0,0 +1,294 @@
+package telegometrics
+
+import (
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// labelSeparator separates label values in keys of collectors
+const labelSeparator = "\xff"
+
+// counterVec represents counter partitioned by labels
+type counterVec struct {
+	name   string
+	help   string
+	labels []string
+
+	lock   sync.Mutex
+	values map[string]float64
+}
+
+// newCounterVec creates new counter
+func newCounterVec(name, help string, labels ...string) *counterVec {
+	return &counterVec{
+		name:   name,
+		help:   help,
+		labels: labels,
+		values: make(map[string]float64),
+	}
+}
+
+// inc increments counter with label values
+func (c *counterVec) inc(labelValues ...string) {
+	key := strings.Join(labelValues, labelSeparator)
+
+	c.lock.Lock()
+	c.values[key]++
+	c.lock.Unlock()
+}
+
+// value returns counter value with label values
+func (c *counterVec) value(labelValues ...string) float64 {
+	key := strings.Join(labelValues, labelSeparator)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.values[key]
+}
+
+// write writes counter in Prometheus text format
+func (c *counterVec) write(w io.Writer) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	writeHeader(w, c.name, c.help, "counter")
+	for _, key := range sortedKeys(c.values) {
+		writeSample(w, c.name, c.labels, splitKey(key), "", "", c.values[key])
+	}
+}
+
+// histogramVec represents histogram partitioned by labels
+type histogramVec struct {
+	name    string
+	help    string
+	labels  []string
+	buckets []float64
+
+	lock   sync.Mutex
+	values map[string]*histogram
+}
+
+// histogram represents histogram values
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// newHistogramVec creates new histogram
+func newHistogramVec(name, help string, buckets []float64, labels ...string) *histogramVec {
+	return &histogramVec{
+		name:    name,
+		help:    help,
+		labels:  labels,
+		buckets: buckets,
+		values:  make(map[string]*histogram),
+	}
+}
+
+// observe adds observation to histogram with label values
+func (h *histogramVec) observe(value float64, labelValues ...string) {
+	key := strings.Join(labelValues, labelSeparator)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	hist, ok := h.values[key]
+	if !ok {
+		hist = &histogram{counts: make([]uint64, len(h.buckets))}
+		h.values[key] = hist
+	}
+
+	for i, bucket := range h.buckets {
+		if value <= bucket {
+			hist.counts[i]++
+		}
+	}
+	hist.count++
+	hist.sum += value
+}
+
+// count returns number of observations with label values
+func (h *histogramVec) count(labelValues ...string) uint64 {
+	key := strings.Join(labelValues, labelSeparator)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if hist, ok := h.values[key]; ok {
+		return hist.count
+	}
+	return 0
+}
+
+// write writes histogram in Prometheus text format
+func (h *histogramVec) write(w io.Writer) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	writeHeader(w, h.name, h.help, "histogram")
+	for _, key := range sortedKeys(h.values) {
+		hist := h.values[key]
+		labelValues := splitKey(key)
+
+		for i, bucket := range h.buckets {
+			writeSample(w, h.name+"_bucket", h.labels, labelValues, "le", formatFloat(bucket),
+				float64(hist.counts[i]))
+		}
+		writeSample(w, h.name+"_bucket", h.labels, labelValues, "le", "+Inf", float64(hist.count))
+		writeSample(w, h.name+"_sum", h.labels, labelValues, "", "", hist.sum)
+		writeSample(w, h.name+"_count", h.labels, labelValues, "", "", float64(hist.count))
+	}
+}
+
+// funcVec represents gauge or counter partitioned by labels with values computed on collection
+type funcVec struct {
+	name       string
+	help       string
+	metricType string
+	labels     []string
+
+	lock   sync.Mutex
+	values map[string]func() float64
+}
+
+// newGaugeFuncVec creates new gauge
+func newGaugeFuncVec(name, help string, labels ...string) *funcVec {
+	return &funcVec{
+		name:       name,
+		help:       help,
+		metricType: "gauge",
+		labels:     labels,
+		values:     make(map[string]func() float64),
+	}
+}
+
+// newCounterFuncVec creates new counter, value functions must be monotonic
+func newCounterFuncVec(name, help string, labels ...string) *funcVec {
+	c := newGaugeFuncVec(name, help, labels...)
+	c.metricType = "counter"
+	return c
+}
+
+// set sets value function with label values
+func (g *funcVec) set(value func() float64, labelValues ...string) {
+	key := strings.Join(labelValues, labelSeparator)
+
+	g.lock.Lock()
+	g.values[key] = value
+	g.lock.Unlock()
+}
+
+// remove removes value function with label values
+func (g *funcVec) remove(labelValues ...string) {
+	key := strings.Join(labelValues, labelSeparator)
+
+	g.lock.Lock()
+	delete(g.values, key)
+	g.lock.Unlock()
+}
+
+// write writes values in Prometheus text format
+func (g *funcVec) write(w io.Writer) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	writeHeader(w, g.name, g.help, g.metricType)
+	for _, key := range sortedKeys(g.values) {
+		writeSample(w, g.name, g.labels, splitKey(key), "", "", g.values[key]())
+	}
+}
+
+// sortedKeys returns sorted keys of map
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// splitKey splits key into label values
+func splitKey(key string) []string {
+	if key == "" {
+		return nil
+	}
+	return strings.Split(key, labelSeparator)
+}
+
+// writeHeader writes metric help and type
+func writeHeader(w io.Writer, name, help, metricType string) {
+	_, _ = io.WriteString(w, "# HELP "+name+" "+escapeHelp(help)+"\n")
+	_, _ = io.WriteString(w, "# TYPE "+name+" "+metricType+"\n")
+}
+
+// writeSample writes metric sample with labels and optional extra label
+func writeSample(w io.Writer, name string, labels, labelValues []string, extraLabel, extraValue string,
+	value float64,
+) {
+	sb := strings.Builder{}
+	sb.WriteString(name)
+
+	if len(labels) > 0 || extraLabel != "" {
+		sb.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+
+			labelValue := ""
+			if i < len(labelValues) {
+				labelValue = labelValues[i]
+			}
+			sb.WriteString(label + `="` + escapeLabelValue(labelValue) + `"`)
+		}
+		if extraLabel != "" {
+			if len(labels) > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(extraLabel + `="` + extraValue + `"`)
+		}
+		sb.WriteByte('}')
+	}
+
+	sb.WriteByte(' ')
+	sb.WriteString(formatFloat(value))
+	sb.WriteByte('\n')
+
+	_, _ = io.WriteString(w, sb.String())
+}
+
+// formatFloat formats float in Prometheus text format
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+// labelValueReplacer escapes label values
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes label value
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+// helpReplacer escapes help text
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+// escapeHelp escapes help text
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
@@ -0,0 +1,25 @@
+/*
+Package telegometrics provides metrics for Telego bots exposed in Prometheus text format.
+
+Metrics collects information about outgoing API calls (count, latency and error codes by method), incoming updates
+(count by update type, handler duration and panics) and update queues (channel occupancy). Webhook queue occupancy and
+updates not delivered because of full queue can be collected using [Metrics.ObserveWebhook], along with webhook health
+reported by webhook watchdog. Collected metrics can be served by any HTTP server, including the one used for webhooks.
+
+	metrics, _ := telegometrics.New()
+
+	bot, _ := telego.NewBot(token, telego.WithInterceptors(metrics.Interceptor()))
+
+	updates, _ := bot.UpdatesViaLongPolling(nil)
+	metrics.ObserveUpdates("long_polling", updates)
+
+	bh, _ := th.NewBotHandler(bot, updates)
+	bh.Use(th.PanicRecovery(), metrics.Middleware())
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+Note: Panics are counted by [Metrics.Middleware] without recovering them, so it should be placed after
+[telegohandler.PanicRecovery] middleware to prevent crashes.
+*/
+package telegometrics
@@ -0,0 +1,276 @@
+package telegometrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+
+	"github.com/mymmrac/telego"
+	ta "github.com/mymmrac/telego/telegoapi"
+	th "github.com/mymmrac/telego/telegohandler"
+)
+
+const (
+	// defaultNamespace default prefix of metric names
+	defaultNamespace = "telego"
+
+	// ContentType content type of Prometheus text format
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	// codeOK code label value of successful API calls
+	codeOK = "200"
+
+	// codeError code label value of API calls failed without response from Telegram
+	codeError = "error"
+
+	// unknownUpdateType update type label value of updates with unknown type
+	unknownUpdateType = "unknown"
+
+	// webhookQueue queue label value of webhook updates queue
+	webhookQueue = "webhook"
+)
+
+// DefaultBuckets default histogram buckets in seconds
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// namespaceRegexp valid metric namespace
+var namespaceRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Metrics represents collection of bot metrics
+type Metrics struct {
+	namespace string
+	buckets   []float64
+
+	apiRequests        *counterVec
+	apiRequestDuration *histogramVec
+	updates            *counterVec
+	handlerDuration    *histogramVec
+	handlerPanics      *counterVec
+	queueLength        *funcVec
+	queueCapacity      *funcVec
+	spilledUpdates     *funcVec
+	overflowUpdates    *funcVec
+	webhookPending     *funcVec
+	webhookLastError   *funcVec
+	webhookLastSync    *funcVec
+	webhookReady       *funcVec
+}
+
+// MetricsOption represents an option that can be applied to Metrics
+type MetricsOption func(m *Metrics) error
+
+// WithNamespace sets prefix of metric names, default is "telego"
+func WithNamespace(namespace string) MetricsOption {
+	return func(m *Metrics) error {
+		if !namespaceRegexp.MatchString(namespace) {
+			return errors.New("invalid namespace")
+		}
+
+		m.namespace = namespace
+		return nil
+	}
+}
+
+// WithBuckets sets histogram buckets in seconds, [DefaultBuckets] used by default
+func WithBuckets(buckets ...float64) MetricsOption {
+	return func(m *Metrics) error {
+		if len(buckets) == 0 {
+			return errors.New("empty buckets")
+		}
+		if !sort.Float64sAreSorted(buckets) {
+			return errors.New("buckets are not sorted")
+		}
+
+		m.buckets = buckets
+		return nil
+	}
+}
+
+// New creates new metrics with given options
+func New(options ...MetricsOption) (*Metrics, error) {
+	m := &Metrics{
+		namespace: defaultNamespace,
+		buckets:   DefaultBuckets,
+	}
+
+	for _, option := range options {
+		if err := option(m); err != nil {
+			return nil, fmt.Errorf("telego: metrics options: %w", err)
+		}
+	}
+
+	ns := m.namespace + "_"
+	m.apiRequests = newCounterVec(ns+"api_requests_total",
+		"Total number of Bot API requests by method and response code.", "method", "code")
+	m.apiRequestDuration = newHistogramVec(ns+"api_request_duration_seconds",
+		"Duration of Bot API requests in seconds by method.", m.buckets, "method")
+	m.updates = newCounterVec(ns+"updates_total",
+		"Total number of handled updates by type.", "type")
+	m.handlerDuration = newHistogramVec(ns+"handler_duration_seconds",
+		"Duration of update handling in seconds by update type.", m.buckets, "type")
+	m.handlerPanics = newCounterVec(ns+"handler_panics_total",
+		"Total number of panics during update handling by update type.", "type")
+	m.queueLength = newGaugeFuncVec(ns+"updates_queue_length",
+		"Number of updates waiting in queue.", "queue")
+	m.queueCapacity = newGaugeFuncVec(ns+"updates_queue_capacity",
+		"Capacity of updates queue.", "queue")
+	m.spilledUpdates = newGaugeFuncVec(ns+"webhook_spilled_updates",
+		"Number of webhook updates waiting in spill queue.")
+	m.overflowUpdates = newCounterFuncVec(ns+"webhook_overflow_updates_total",
+		"Total number of webhook updates not delivered because of full queue by action.", "action")
+	m.webhookPending = newGaugeFuncVec(ns+"webhook_pending_updates",
+		"Number of updates pending delivery to webhook reported by Telegram.")
+	m.webhookLastError = newGaugeFuncVec(ns+"webhook_last_error_timestamp_seconds",
+		"Unix time of the last webhook delivery error reported by Telegram.")
+	m.webhookLastSync = newGaugeFuncVec(ns+"webhook_last_synchronization_error_timestamp_seconds",
+		"Unix time of the last synchronization error reported by Telegram.")
+	m.webhookReady = newGaugeFuncVec(ns+"webhook_ready",
+		"Whether webhook is registered as configured and receives updates without errors (1) or not (0).")
+
+	return m, nil
+}
+
+// Interceptor returns bot interceptor that collects metrics of outgoing API calls
+func (m *Metrics) Interceptor() telego.Interceptor {
+	return func(ctx context.Context, call *telego.APICall, next telego.APICallHandler) error {
+		start := time.Now()
+		err := next(ctx, call)
+		duration := time.Since(start)
+
+		code := codeOK
+		if err != nil {
+			code = codeError
+			if apiErr, ok := ta.AsError(err); ok && apiErr.ErrorCode != 0 {
+				code = strconv.Itoa(apiErr.ErrorCode)
+			}
+		}
+
+		m.apiRequests.inc(call.Method, code)
+		m.apiRequestDuration.observe(duration.Seconds(), call.Method)
+
+		return err
+	}
+}
+
+// Middleware returns handler middleware that collects metrics of handled updates
+// Note: Panics are counted, but not recovered, use [telegohandler.PanicRecovery] before this middleware to recover
+func (m *Metrics) Middleware() th.Middleware {
+	return func(bot *telego.Bot, update telego.Update, next th.Handler) {
+		updateType := update.Type()
+		if updateType == "" {
+			updateType = unknownUpdateType
+		}
+		m.updates.inc(updateType)
+
+		start := time.Now()
+		completed := false
+		defer func() {
+			m.handlerDuration.observe(time.Since(start).Seconds(), updateType)
+			if !completed {
+				m.handlerPanics.inc(updateType)
+			}
+		}()
+
+		next(bot, update)
+		completed = true
+	}
+}
+
+// ObserveUpdates registers updates channel, its length and capacity will be reported with queue name
+// Note: Registering queue with the same name replaces previous one
+func (m *Metrics) ObserveUpdates(queue string, updates <-chan telego.Update) {
+	m.ObserveQueue(queue, func() int { return len(updates) }, func() int { return cap(updates) })
+}
+
+// ObserveQueue registers any queue by its length and capacity functions, useful for custom update sources
+// Note: Registering queue with the same name replaces previous one
+func (m *Metrics) ObserveQueue(queue string, length, capacity func() int) {
+	m.queueLength.set(func() float64 { return float64(length()) }, queue)
+	m.queueCapacity.set(func() float64 { return float64(capacity()) }, queue)
+}
+
+// ObserveWebhook registers webhook of bot, its queue occupancy will be reported with "webhook" queue name, along with
+// spill queue occupancy and updates dropped or rejected by overflow policy (see [telego.WithWebhookOverflow]).
+// If webhook watchdog is configured (see [telego.WithWebhookWatchdog]), webhook health is reported too.
+// Note: Webhook should be configured using [telego.Bot.UpdatesViaWebhook] method before calling this method
+func (m *Metrics) ObserveWebhook(bot *telego.Bot) {
+	m.ObserveQueue(webhookQueue,
+		func() int { return bot.WebhookQueueStats().Length },
+		func() int { return bot.WebhookQueueStats().Capacity },
+	)
+	m.spilledUpdates.set(func() float64 { return float64(bot.WebhookQueueStats().Spilled) })
+	m.overflowUpdates.set(func() float64 { return float64(bot.WebhookQueueStats().Dropped) }, "dropped")
+	m.overflowUpdates.set(func() float64 { return float64(bot.WebhookQueueStats().Rejected) }, "rejected")
+
+	if _, ok := bot.WebhookHealth(); !ok {
+		return
+	}
+
+	health := func() telego.WebhookHealth {
+		h, _ := bot.WebhookHealth()
+		return h
+	}
+	m.webhookPending.set(func() float64 { return float64(health().Info.PendingUpdateCount) })
+	m.webhookLastError.set(func() float64 { return float64(health().Info.LastErrorDate) })
+	m.webhookLastSync.set(func() float64 { return float64(health().Info.LastSynchronizationErrorDate) })
+	m.webhookReady.set(func() float64 {
+		if health().Ready {
+			return 1
+		}
+		return 0
+	})
+}
+
+// RemoveQueue stops reporting queue with specified name
+func (m *Metrics) RemoveQueue(queue string) {
+	m.queueLength.remove(queue)
+	m.queueCapacity.remove(queue)
+}
+
+// WriteTo writes all metrics in Prometheus text format
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	sb := &strings.Builder{}
+
+	m.apiRequests.write(sb)
+	m.apiRequestDuration.write(sb)
+	m.updates.write(sb)
+	m.handlerDuration.write(sb)
+	m.handlerPanics.write(sb)
+	m.queueLength.write(sb)
+	m.queueCapacity.write(sb)
+	m.spilledUpdates.write(sb)
+	m.overflowUpdates.write(sb)
+	m.webhookPending.write(sb)
+	m.webhookLastError.write(sb)
+	m.webhookLastSync.write(sb)
+	m.webhookReady.write(sb)
+
+	n, err := io.WriteString(w, sb.String())
+	return int64(n), err
+}
+
+// Handler returns HTTP handler that serves metrics in Prometheus text format, can be registered in
+// [telego.HTTPWebhookServer.ServeMux]
+func (m *Metrics) Handler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", ContentType)
+		writer.WriteHeader(http.StatusOK)
+		_, _ = m.WriteTo(writer)
+	})
+}
+
+// FastHTTPHandler returns fasthttp handler that serves metrics in Prometheus text format, can be registered in
+// [telego.FastHTTPWebhookServer.Router]
+func (m *Metrics) FastHTTPHandler() fasthttp.RequestHandler {
+	return fasthttpadaptor.NewFastHTTPHandler(m.Handler())
+}
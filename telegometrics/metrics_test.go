@@ -0,0 +1,247 @@
+package telegometrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+
+	"github.com/mymmrac/telego"
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+const testToken = "1234567890:aaaabbbbaaaabbbbaaaabbbbaaaabbbbccc"
+
+var (
+	errTest = errors.New("test")
+	posInf  = math.Inf(1)
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m, err := New(WithNamespace("bot"), WithBuckets(1, 2))
+		require.NoError(t, err)
+		assert.Equal(t, "bot", m.namespace)
+		assert.Equal(t, []float64{1, 2}, m.buckets)
+	})
+
+	t.Run("error_namespace", func(t *testing.T) {
+		m, err := New(WithNamespace("bad namespace"))
+		require.Error(t, err)
+		assert.Nil(t, m)
+	})
+
+	t.Run("error_buckets", func(t *testing.T) {
+		_, err := New(WithBuckets())
+		require.Error(t, err)
+
+		_, err = New(WithBuckets(2, 1))
+		require.Error(t, err)
+	})
+}
+
+func TestMetrics_Interceptor(t *testing.T) {
+	m, err := New()
+	require.NoError(t, err)
+
+	interceptor := m.Interceptor()
+	ctx := context.Background()
+
+	calls := []struct {
+		method string
+		err    error
+	}{
+		{method: "sendMessage", err: nil},
+		{method: "sendMessage", err: nil},
+		{method: "sendMessage", err: fmt.Errorf("api: %w", &ta.Error{ErrorCode: 403})},
+		{method: "getMe", err: errTest},
+	}
+	for _, c := range calls {
+		callErr := interceptor(ctx, &telego.APICall{Method: c.method},
+			func(_ context.Context, _ *telego.APICall) error {
+				return c.err
+			})
+		assert.Equal(t, c.err, callErr)
+	}
+
+	assert.InDelta(t, 2, m.apiRequests.value("sendMessage", "200"), 0)
+	assert.InDelta(t, 1, m.apiRequests.value("sendMessage", "403"), 0)
+	assert.InDelta(t, 1, m.apiRequests.value("getMe", "error"), 0)
+	assert.Equal(t, uint64(3), m.apiRequestDuration.count("sendMessage"))
+	assert.Equal(t, uint64(1), m.apiRequestDuration.count("getMe"))
+}
+
+func TestMetrics_Middleware(t *testing.T) {
+	m, err := New()
+	require.NoError(t, err)
+
+	middleware := m.Middleware()
+
+	called := false
+	middleware(nil, telego.Update{Message: &telego.Message{}}, func(_ *telego.Bot, _ telego.Update) {
+		called = true
+	})
+	assert.True(t, called)
+
+	middleware(nil, telego.Update{}, func(_ *telego.Bot, _ telego.Update) {})
+
+	assert.Panics(t, func() {
+		middleware(nil, telego.Update{CallbackQuery: &telego.CallbackQuery{}}, func(_ *telego.Bot, _ telego.Update) {
+			panic("test")
+		})
+	})
+
+	assert.InDelta(t, 1, m.updates.value("message"), 0)
+	assert.InDelta(t, 1, m.updates.value("unknown"), 0)
+	assert.InDelta(t, 1, m.updates.value("callback_query"), 0)
+	assert.Equal(t, uint64(1), m.handlerDuration.count("message"))
+	assert.Equal(t, uint64(1), m.handlerDuration.count("callback_query"))
+	assert.InDelta(t, 0, m.handlerPanics.value("message"), 0)
+	assert.InDelta(t, 1, m.handlerPanics.value("callback_query"), 0)
+}
+
+func TestMetrics_WriteTo(t *testing.T) {
+	m, err := New(WithNamespace("bot"), WithBuckets(0.5, 1))
+	require.NoError(t, err)
+
+	m.apiRequests.inc("sendMessage", "200")
+	m.apiRequestDuration.observe(0.75, "sendMessage")
+	m.updates.inc("message")
+
+	updates := make(chan telego.Update, 4)
+	updates <- telego.Update{}
+	m.ObserveUpdates("long_polling", updates)
+	m.ObserveQueue(`a"b`, func() int { return 1 }, func() int { return 2 })
+
+	sb := &strings.Builder{}
+	_, err = m.WriteTo(sb)
+	require.NoError(t, err)
+
+	assert.Equal(t, `# HELP bot_api_requests_total Total number of Bot API requests by method and response code.
+# TYPE bot_api_requests_total counter
+bot_api_requests_total{method="sendMessage",code="200"} 1
+# HELP bot_api_request_duration_seconds Duration of Bot API requests in seconds by method.
+# TYPE bot_api_request_duration_seconds histogram
+bot_api_request_duration_seconds_bucket{method="sendMessage",le="0.5"} 0
+bot_api_request_duration_seconds_bucket{method="sendMessage",le="1"} 1
+bot_api_request_duration_seconds_bucket{method="sendMessage",le="+Inf"} 1
+bot_api_request_duration_seconds_sum{method="sendMessage"} 0.75
+bot_api_request_duration_seconds_count{method="sendMessage"} 1
+# HELP bot_updates_total Total number of handled updates by type.
+# TYPE bot_updates_total counter
+bot_updates_total{type="message"} 1
+# HELP bot_handler_duration_seconds Duration of update handling in seconds by update type.
+# TYPE bot_handler_duration_seconds histogram
+# HELP bot_handler_panics_total Total number of panics during update handling by update type.
+# TYPE bot_handler_panics_total counter
+# HELP bot_updates_queue_length Number of updates waiting in queue.
+# TYPE bot_updates_queue_length gauge
+bot_updates_queue_length{queue="a\"b"} 1
+bot_updates_queue_length{queue="long_polling"} 1
+# HELP bot_updates_queue_capacity Capacity of updates queue.
+# TYPE bot_updates_queue_capacity gauge
+bot_updates_queue_capacity{queue="a\"b"} 2
+bot_updates_queue_capacity{queue="long_polling"} 4
+# HELP bot_webhook_spilled_updates Number of webhook updates waiting in spill queue.
+# TYPE bot_webhook_spilled_updates gauge
+# HELP bot_webhook_overflow_updates_total Total number of webhook updates not delivered because of full queue by action.
+# TYPE bot_webhook_overflow_updates_total counter
+# HELP bot_webhook_pending_updates Number of updates pending delivery to webhook reported by Telegram.
+# TYPE bot_webhook_pending_updates gauge
+# HELP bot_webhook_last_error_timestamp_seconds Unix time of the last webhook delivery error reported by Telegram.
+# TYPE bot_webhook_last_error_timestamp_seconds gauge
+# HELP bot_webhook_last_synchronization_error_timestamp_seconds Unix time of the last synchronization error reported by Telegram.
+# TYPE bot_webhook_last_synchronization_error_timestamp_seconds gauge
+# HELP bot_webhook_ready Whether webhook is registered as configured and receives updates without errors (1) or not (0).
+# TYPE bot_webhook_ready gauge
+`, sb.String())
+
+	m.RemoveQueue("long_polling")
+	sb.Reset()
+	_, err = m.WriteTo(sb)
+	require.NoError(t, err)
+	assert.NotContains(t, sb.String(), "long_polling")
+}
+
+func TestMetrics_ObserveWebhook(t *testing.T) {
+	m, err := New()
+	require.NoError(t, err)
+
+	bot, err := telego.NewBot(testToken, telego.WithDiscardLogger())
+	require.NoError(t, err)
+
+	_, err = bot.UpdatesViaWebhook("/bot", telego.WithWebhookBuffer(8))
+	require.NoError(t, err)
+	defer func() { _ = bot.StopWebhook() }()
+
+	m.ObserveWebhook(bot)
+
+	sb := &strings.Builder{}
+	_, err = m.WriteTo(sb)
+	require.NoError(t, err)
+
+	assert.Contains(t, sb.String(), `telego_updates_queue_length{queue="webhook"} 0`)
+	assert.Contains(t, sb.String(), `telego_updates_queue_capacity{queue="webhook"} 8`)
+	assert.Contains(t, sb.String(), "telego_webhook_spilled_updates 0")
+	assert.Contains(t, sb.String(), `telego_webhook_overflow_updates_total{action="dropped"} 0`)
+	assert.Contains(t, sb.String(), `telego_webhook_overflow_updates_total{action="rejected"} 0`)
+	assert.NotContains(t, sb.String(), "telego_webhook_ready 0")
+
+	t.Run("watchdog", func(t *testing.T) {
+		watchdogBot, err := telego.NewBot(testToken, telego.WithDiscardLogger())
+		require.NoError(t, err)
+
+		_, err = watchdogBot.UpdatesViaWebhook("/bot", telego.WithWebhookWatchdog(&telego.SetWebhookParams{
+			URL: "https://example.com/bot",
+		}, telego.WebhookWatchdogConfig{}))
+		require.NoError(t, err)
+		defer func() { _ = watchdogBot.StopWebhook() }()
+
+		m.ObserveWebhook(watchdogBot)
+
+		sb.Reset()
+		_, err = m.WriteTo(sb)
+		require.NoError(t, err)
+
+		assert.Contains(t, sb.String(), "telego_webhook_pending_updates 0")
+		assert.Contains(t, sb.String(), "telego_webhook_last_error_timestamp_seconds 0")
+		assert.Contains(t, sb.String(), "telego_webhook_last_synchronization_error_timestamp_seconds 0")
+		assert.Contains(t, sb.String(), "telego_webhook_ready 0")
+	})
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m, err := New()
+	require.NoError(t, err)
+	m.updates.inc("message")
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, ContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `telego_updates_total{type="message"} 1`)
+
+	fastCtx := &fasthttp.RequestCtx{}
+	m.FastHTTPHandler()(fastCtx)
+
+	assert.Equal(t, fasthttp.StatusOK, fastCtx.Response.StatusCode())
+	assert.Equal(t, ContentType, string(fastCtx.Response.Header.ContentType()))
+	assert.Contains(t, string(fastCtx.Response.Body()), `telego_updates_total{type="message"} 1`)
+}
+
+func Test_formatFloat(t *testing.T) {
+	assert.Equal(t, "1", formatFloat(1))
+	assert.Equal(t, "0.25", formatFloat(0.25))
+	assert.Equal(t, "+Inf", formatFloat(posInf))
+	assert.Equal(t, "-Inf", formatFloat(-posInf))
+	assert.Equal(t, "NaN", formatFloat(posInf-posInf))
+}
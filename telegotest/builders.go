@@ -0,0 +1,421 @@
+package telegotest
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+	"unicode/utf16"
+
+	"github.com/mymmrac/telego"
+)
+
+// DefaultUser user used by builders if sender is not set
+var DefaultUser = telego.User{
+	ID:           100000001,
+	FirstName:    "Test",
+	LastName:     "User",
+	Username:     "test_user",
+	LanguageCode: "en",
+}
+
+// BotUser returns bot user of [Token] (same as [Server.Me])
+func BotUser() telego.User {
+	return telego.User{
+		ID:        BotID,
+		IsBot:     true,
+		FirstName: "Test Bot",
+		Username:  "test_bot",
+	}
+}
+
+var (
+	// lastUpdateID last update ID generated by builders
+	lastUpdateID atomic.Int64
+
+	// lastMessageID last message ID generated by builders
+	lastMessageID atomic.Int64
+
+	// lastCallbackID last callback query ID generated by builders
+	lastCallbackID atomic.Int64
+)
+
+func init() {
+	lastUpdateID.Store(500000000)
+	lastMessageID.Store(1000)
+	lastCallbackID.Store(4000000000000000000)
+}
+
+// nextUpdateID returns unique update ID
+func nextUpdateID() int {
+	return int(lastUpdateID.Add(1))
+}
+
+// nextMessageID returns unique message ID
+func nextMessageID() int {
+	return int(lastMessageID.Add(1))
+}
+
+// entityPatterns patterns of entities that are detected automatically in message text
+var entityPatterns = []struct {
+	entityType string
+	pattern    *regexp.Regexp
+}{
+	{entityType: telego.EntityTypeBotCommand, pattern: regexp.MustCompile(`(?:^|\s)(/\w{1,32}(?:@\w{5,32})?)`)},
+	{entityType: telego.EntityTypeMention, pattern: regexp.MustCompile(`(?:^|\s)(@\w{5,32})`)},
+	{entityType: telego.EntityTypeHashtag, pattern: regexp.MustCompile(`(?:^|\s)(#\w+)`)},
+	{entityType: telego.EntityTypeURL, pattern: regexp.MustCompile(`(?:^|\s)(https?://\S+)`)},
+}
+
+// TextEntities returns entities that Telegram would detect in text (bot commands, mentions, hashtags and URLs),
+// offsets and lengths are in UTF-16 code units
+func TextEntities(text string) []telego.MessageEntity {
+	var entities []telego.MessageEntity
+	for _, p := range entityPatterns {
+		for _, match := range p.pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := match[2], match[3]
+			entities = append(entities, telego.MessageEntity{
+				Type:   p.entityType,
+				Offset: utf16Len(text[:start]),
+				Length: utf16Len(text[start:end]),
+			})
+		}
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Offset < entities[j].Offset
+	})
+	return entities
+}
+
+// utf16Len returns length of text in UTF-16 code units
+func utf16Len(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
+
+// MessageBuilder builds messages sent by users, by default message is sent by [DefaultUser] in private chat with
+// the bot and entities are detected automatically (see [TextEntities])
+type MessageBuilder struct {
+	msg          telego.Message
+	chat         *telego.Chat
+	from         telego.User
+	date         time.Time
+	autoEntities bool
+}
+
+// Text creates builder of text message
+func Text(text string) *MessageBuilder {
+	return &MessageBuilder{
+		msg:          telego.Message{Text: text},
+		from:         DefaultUser,
+		autoEntities: true,
+	}
+}
+
+// Caption creates builder of message with media (set using [MessageBuilder.Modify]) and caption
+func Caption(caption string) *MessageBuilder {
+	return &MessageBuilder{
+		msg:          telego.Message{Caption: caption},
+		from:         DefaultUser,
+		autoEntities: true,
+	}
+}
+
+// From sets sender of the message
+func (b *MessageBuilder) From(user telego.User) *MessageBuilder {
+	b.from = user
+	return b
+}
+
+// InChat sets chat of the message
+func (b *MessageBuilder) InChat(chat telego.Chat) *MessageBuilder {
+	b.chat = &chat
+	return b
+}
+
+// InGroup sets group chat of the message, supergroup type is used if chat type is not set
+func (b *MessageBuilder) InGroup(chat telego.Chat) *MessageBuilder {
+	if chat.Type == "" {
+		chat.Type = telego.ChatTypeSupergroup
+	}
+	return b.InChat(chat)
+}
+
+// InThread sets forum topic of the message
+func (b *MessageBuilder) InThread(threadID int) *MessageBuilder {
+	b.msg.MessageThreadID = threadID
+	b.msg.IsTopicMessage = true
+	return b
+}
+
+// ReplyTo sets message that is replied to
+func (b *MessageBuilder) ReplyTo(msg telego.Message) *MessageBuilder {
+	b.msg.ReplyToMessage = &msg
+	return b
+}
+
+// WithID sets message ID, by default unique ID is generated
+func (b *MessageBuilder) WithID(messageID int) *MessageBuilder {
+	b.msg.MessageID = messageID
+	return b
+}
+
+// WithEntities sets entities of the message, automatic detection of entities is disabled
+func (b *MessageBuilder) WithEntities(entities ...telego.MessageEntity) *MessageBuilder {
+	b.autoEntities = false
+	if b.msg.Text != "" {
+		b.msg.Entities = entities
+	} else {
+		b.msg.CaptionEntities = entities
+	}
+	return b
+}
+
+// At sets date of the message, by default current time is used
+func (b *MessageBuilder) At(date time.Time) *MessageBuilder {
+	b.date = date
+	return b
+}
+
+// Modify applies changes to the message that don't have dedicated builder methods
+func (b *MessageBuilder) Modify(modify func(msg *telego.Message)) *MessageBuilder {
+	modify(&b.msg)
+	return b
+}
+
+// Message returns built message
+func (b *MessageBuilder) Message() telego.Message {
+	msg := b.msg
+	from := b.from
+	msg.From = &from
+
+	if b.chat != nil {
+		msg.Chat = *b.chat
+	} else {
+		msg.Chat = PrivateChat(from)
+	}
+
+	if msg.MessageID == 0 {
+		msg.MessageID = nextMessageID()
+	}
+
+	if b.date.IsZero() {
+		msg.Date = time.Now().Unix()
+	} else {
+		msg.Date = b.date.Unix()
+	}
+
+	if b.autoEntities {
+		if msg.Text != "" {
+			msg.Entities = TextEntities(msg.Text)
+		} else {
+			msg.CaptionEntities = TextEntities(msg.Caption)
+		}
+	}
+
+	return msg
+}
+
+// Update returns update with built message
+func (b *MessageBuilder) Update() telego.Update {
+	msg := b.Message()
+	return telego.Update{
+		UpdateID: nextUpdateID(),
+		Message:  &msg,
+	}
+}
+
+// Edited returns update with built message as edited message, edit date is set to the current time
+func (b *MessageBuilder) Edited() telego.Update {
+	msg := b.Message()
+	msg.EditDate = time.Now().Unix()
+	return telego.Update{
+		UpdateID:      nextUpdateID(),
+		EditedMessage: &msg,
+	}
+}
+
+// CallbackBuilder builds callback queries, by default query is sent by [DefaultUser] from message sent by the bot in
+// private chat with the user
+type CallbackBuilder struct {
+	query   telego.CallbackQuery
+	message telego.MaybeInaccessibleMessage
+}
+
+// Callback creates builder of callback query with data
+func Callback(data string) *CallbackBuilder {
+	return &CallbackBuilder{
+		query: telego.CallbackQuery{
+			From: DefaultUser,
+			Data: data,
+		},
+	}
+}
+
+// From sets sender of the callback query
+func (b *CallbackBuilder) From(user telego.User) *CallbackBuilder {
+	b.query.From = user
+	return b
+}
+
+// OnMessage sets message with the button that originated the query
+func (b *CallbackBuilder) OnMessage(msg telego.Message) *CallbackBuilder {
+	b.message = &msg
+	b.query.InlineMessageID = ""
+	return b
+}
+
+// OnInaccessibleMessage sets message with the button that is no longer accessible (for example, message is too old)
+func (b *CallbackBuilder) OnInaccessibleMessage(chat telego.Chat, messageID int) *CallbackBuilder {
+	b.message = &telego.InaccessibleMessage{
+		Chat:      chat,
+		MessageID: messageID,
+	}
+	b.query.InlineMessageID = ""
+	return b
+}
+
+// OnInlineMessage sets inline message with the button that originated the query
+func (b *CallbackBuilder) OnInlineMessage(inlineMessageID string) *CallbackBuilder {
+	b.message = nil
+	b.query.InlineMessageID = inlineMessageID
+	return b
+}
+
+// CallbackQuery returns built callback query
+func (b *CallbackBuilder) CallbackQuery() telego.CallbackQuery {
+	query := b.query
+	query.ID = strconv.FormatInt(lastCallbackID.Add(1), 10)
+	query.Message = b.message
+
+	if query.Message == nil && query.InlineMessageID == "" {
+		bot := BotUser()
+		query.Message = &telego.Message{
+			MessageID: nextMessageID(),
+			From:      &bot,
+			Date:      time.Now().Unix(),
+			Chat:      PrivateChat(query.From),
+		}
+	}
+
+	if query.Message != nil {
+		query.ChatInstance = strconv.FormatInt(query.Message.GetChat().ID, 10)
+	} else {
+		query.ChatInstance = strconv.FormatInt(query.From.ID, 10)
+	}
+
+	return query
+}
+
+// Update returns update with built callback query
+func (b *CallbackBuilder) Update() telego.Update {
+	query := b.CallbackQuery()
+	return telego.Update{
+		UpdateID:      nextUpdateID(),
+		CallbackQuery: &query,
+	}
+}
+
+// ChatMemberBuilder builds chat member updates, by default member joins the chat on their own
+type ChatMemberBuilder struct {
+	update telego.ChatMemberUpdated
+	my     bool
+}
+
+// ChatMember creates builder of chat member update of user in chat
+func ChatMember(chat telego.Chat, user telego.User) *ChatMemberBuilder {
+	return &ChatMemberBuilder{
+		update: telego.ChatMemberUpdated{
+			Chat:          chat,
+			From:          user,
+			OldChatMember: &telego.ChatMemberLeft{Status: telego.MemberStatusLeft, User: user},
+			NewChatMember: &telego.ChatMemberMember{Status: telego.MemberStatusMember, User: user},
+		},
+	}
+}
+
+// MyChatMember creates builder of chat member update of the bot in chat (my_chat_member update), by default the
+// bot is added to the chat by [DefaultUser]
+func MyChatMember(chat telego.Chat) *ChatMemberBuilder {
+	b := ChatMember(chat, BotUser())
+	b.update.From = DefaultUser
+	b.my = true
+	return b
+}
+
+// By sets user who changed the member status
+func (b *ChatMemberBuilder) By(user telego.User) *ChatMemberBuilder {
+	b.update.From = user
+	return b
+}
+
+// Change sets old and new member status
+func (b *ChatMemberBuilder) Change(oldMember, newMember telego.ChatMember) *ChatMemberBuilder {
+	b.update.OldChatMember = oldMember
+	b.update.NewChatMember = newMember
+	return b
+}
+
+// Joined sets member status change from left to member
+func (b *ChatMemberBuilder) Joined() *ChatMemberBuilder {
+	user := b.user()
+	return b.Change(
+		&telego.ChatMemberLeft{Status: telego.MemberStatusLeft, User: user},
+		&telego.ChatMemberMember{Status: telego.MemberStatusMember, User: user},
+	)
+}
+
+// Left sets member status change from member to left
+func (b *ChatMemberBuilder) Left() *ChatMemberBuilder {
+	user := b.user()
+	return b.Change(
+		&telego.ChatMemberMember{Status: telego.MemberStatusMember, User: user},
+		&telego.ChatMemberLeft{Status: telego.MemberStatusLeft, User: user},
+	)
+}
+
+// Banned sets member status change from member to banned (for the bot it means that the bot was blocked by the user
+// in private chats)
+func (b *ChatMemberBuilder) Banned() *ChatMemberBuilder {
+	user := b.user()
+	return b.Change(
+		&telego.ChatMemberMember{Status: telego.MemberStatusMember, User: user},
+		&telego.ChatMemberBanned{Status: telego.MemberStatusBanned, User: user},
+	)
+}
+
+// user returns user whose status changes
+func (b *ChatMemberBuilder) user() telego.User {
+	return b.update.NewChatMember.MemberUser()
+}
+
+// ChatMemberUpdated returns built chat member update
+func (b *ChatMemberBuilder) ChatMemberUpdated() telego.ChatMemberUpdated {
+	updated := b.update
+	updated.Date = time.Now().Unix()
+	return updated
+}
+
+// Update returns update with built chat member update
+func (b *ChatMemberBuilder) Update() telego.Update {
+	updated := b.ChatMemberUpdated()
+	update := telego.Update{UpdateID: nextUpdateID()}
+	if b.my {
+		update.MyChatMember = &updated
+	} else {
+		update.ChatMember = &updated
+	}
+	return update
+}
+
+// Updates returns closed channel with updates, it can be passed to [telegohandler.NewBotHandler] to process updates
+// in tests, handler stops once all updates are received
+func Updates(updates ...telego.Update) <-chan telego.Update {
+	ch := make(chan telego.Update, len(updates))
+	for _, update := range updates {
+		ch <- update
+	}
+	close(ch)
+	return ch
+}
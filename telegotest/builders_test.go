@@ -0,0 +1,236 @@
+package telegotest
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/telego"
+	th "github.com/mymmrac/telego/telegohandler"
+)
+
+func TestTextEntities(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		entities []telego.MessageEntity
+	}{
+		{
+			name:     "empty",
+			text:     "",
+			entities: nil,
+		},
+		{
+			name: "command",
+			text: "/start ref",
+			entities: []telego.MessageEntity{
+				{Type: telego.EntityTypeBotCommand, Offset: 0, Length: 6},
+			},
+		},
+		{
+			name: "command_with_username",
+			text: "/help@test_bot",
+			entities: []telego.MessageEntity{
+				{Type: telego.EntityTypeBotCommand, Offset: 0, Length: 14},
+			},
+		},
+		{
+			name: "utf16",
+			text: "😀 /start @test_user #tag https://example.com",
+			entities: []telego.MessageEntity{
+				{Type: telego.EntityTypeBotCommand, Offset: 3, Length: 6},
+				{Type: telego.EntityTypeMention, Offset: 10, Length: 10},
+				{Type: telego.EntityTypeHashtag, Offset: 21, Length: 4},
+				{Type: telego.EntityTypeURL, Offset: 26, Length: 19},
+			},
+		},
+		{
+			name:     "not_command",
+			text:     "a/b",
+			entities: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.entities, TextEntities(tt.text))
+		})
+	}
+}
+
+func TestMessageBuilder(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		update := Text("/start ref").Update()
+		require.NotNil(t, update.Message)
+		assert.NotZero(t, update.UpdateID)
+
+		msg := update.Message
+		assert.NotZero(t, msg.MessageID)
+		assert.NotZero(t, msg.Date)
+		assert.Equal(t, &DefaultUser, msg.From)
+		assert.Equal(t, PrivateChat(DefaultUser), msg.Chat)
+		assert.Equal(t, TextEntities("/start ref"), msg.Entities)
+
+		next := Text("next").Update()
+		assert.Greater(t, next.UpdateID, update.UpdateID)
+		assert.Greater(t, next.Message.MessageID, msg.MessageID)
+	})
+
+	t.Run("group", func(t *testing.T) {
+		user := telego.User{ID: 7, FirstName: "Other"}
+		replyTo := Text("Question").Message()
+		date := time.Unix(1700000000, 0)
+
+		msg := Text("Answer").From(user).InGroup(telego.Chat{ID: -1, Title: "Group"}).InThread(3).
+			ReplyTo(replyTo).At(date).WithID(42).Message()
+		assert.Equal(t, &user, msg.From)
+		assert.Equal(t, telego.Chat{ID: -1, Type: telego.ChatTypeSupergroup, Title: "Group"}, msg.Chat)
+		assert.Equal(t, 3, msg.MessageThreadID)
+		assert.True(t, msg.IsTopicMessage)
+		assert.Equal(t, &replyTo, msg.ReplyToMessage)
+		assert.Equal(t, date.Unix(), msg.Date)
+		assert.Equal(t, 42, msg.MessageID)
+	})
+
+	t.Run("caption", func(t *testing.T) {
+		msg := Caption("#photo").Modify(func(msg *telego.Message) {
+			msg.Photo = []telego.PhotoSize{{FileID: "id"}}
+		}).Message()
+		assert.Len(t, msg.Photo, 1)
+		assert.Equal(t, TextEntities("#photo"), msg.CaptionEntities)
+		assert.Empty(t, msg.Entities)
+	})
+
+	t.Run("entities", func(t *testing.T) {
+		entity := telego.MessageEntity{Type: telego.EntityTypeBold, Offset: 0, Length: 4}
+		msg := Text("/cmd").WithEntities(entity).Message()
+		assert.Equal(t, []telego.MessageEntity{entity}, msg.Entities)
+	})
+
+	t.Run("edited", func(t *testing.T) {
+		update := Text("Text").Edited()
+		require.NotNil(t, update.EditedMessage)
+		assert.Nil(t, update.Message)
+		assert.NotZero(t, update.EditedMessage.EditDate)
+	})
+}
+
+func TestCallbackBuilder(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		update := Callback("data").Update()
+		require.NotNil(t, update.CallbackQuery)
+
+		query := update.CallbackQuery
+		assert.NotEmpty(t, query.ID)
+		assert.Equal(t, "data", query.Data)
+		assert.Equal(t, DefaultUser, query.From)
+		require.NotNil(t, query.Message)
+		assert.True(t, query.Message.IsAccessible())
+		assert.Equal(t, DefaultUser.ID, query.Message.GetChat().ID)
+		assert.NotEmpty(t, query.ChatInstance)
+	})
+
+	t.Run("message", func(t *testing.T) {
+		msg := Text("Message").Message()
+		query := Callback("data").OnMessage(msg).CallbackQuery()
+		assert.Equal(t, &msg, query.Message)
+	})
+
+	t.Run("inaccessible", func(t *testing.T) {
+		chat := PrivateChat(DefaultUser)
+		query := Callback("data").OnInaccessibleMessage(chat, 5).CallbackQuery()
+		require.NotNil(t, query.Message)
+		assert.False(t, query.Message.IsAccessible())
+		assert.Equal(t, 5, query.Message.GetMessageID())
+	})
+
+	t.Run("inline", func(t *testing.T) {
+		query := Callback("data").OnInlineMessage("inline").CallbackQuery()
+		assert.Nil(t, query.Message)
+		assert.Equal(t, "inline", query.InlineMessageID)
+	})
+}
+
+func TestChatMemberBuilder(t *testing.T) {
+	chat := telego.Chat{ID: -1, Type: telego.ChatTypeGroup}
+
+	t.Run("joined", func(t *testing.T) {
+		update := ChatMember(chat, DefaultUser).Update()
+		require.NotNil(t, update.ChatMember)
+		assert.Nil(t, update.MyChatMember)
+		assert.Equal(t, telego.MemberStatusLeft, update.ChatMember.OldChatMember.MemberStatus())
+		assert.Equal(t, telego.MemberStatusMember, update.ChatMember.NewChatMember.MemberStatus())
+		assert.Equal(t, DefaultUser, update.ChatMember.From)
+	})
+
+	t.Run("banned", func(t *testing.T) {
+		admin := telego.User{ID: 2, FirstName: "Admin"}
+		updated := ChatMember(chat, DefaultUser).By(admin).Banned().ChatMemberUpdated()
+		assert.Equal(t, admin, updated.From)
+		assert.Equal(t, telego.MemberStatusBanned, updated.NewChatMember.MemberStatus())
+		assert.Equal(t, DefaultUser, updated.NewChatMember.MemberUser())
+	})
+
+	t.Run("my_left", func(t *testing.T) {
+		update := MyChatMember(chat).Left().Update()
+		require.NotNil(t, update.MyChatMember)
+		assert.Nil(t, update.ChatMember)
+		assert.Equal(t, BotUser(), update.MyChatMember.NewChatMember.MemberUser())
+		assert.Equal(t, telego.MemberStatusLeft, update.MyChatMember.NewChatMember.MemberStatus())
+	})
+}
+
+func TestUpdates_BotHandler(t *testing.T) {
+	bot, err := telego.NewBot(Token, telego.WithDiscardLogger())
+	require.NoError(t, err)
+
+	bh, err := th.NewBotHandler(bot, Updates(
+		Text("/start ref").Update(),
+		Text("hello").Update(),
+		Callback("pressed").Update(),
+	))
+	require.NoError(t, err)
+
+	lock := sync.Mutex{}
+	var handled []string
+	record := func(name string) {
+		lock.Lock()
+		defer lock.Unlock()
+		handled = append(handled, name)
+	}
+
+	bh.Handle(func(_ *telego.Bot, _ telego.Update) {
+		record("start")
+	}, th.CommandEqualArgv("start", "ref"))
+	bh.HandleCallbackQuery(func(_ *telego.Bot, _ telego.CallbackQuery) {
+		record("callback")
+	}, th.CallbackDataEqual("pressed"))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bh.Start()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("handler was not stopped")
+	}
+
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return assert.ObjectsAreEqual([]string{"callback", "start"}, sorted(handled))
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func sorted(values []string) []string {
+	values = append([]string(nil), values...)
+	sort.Strings(values)
+	return values
+}
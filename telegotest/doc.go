@@ -0,0 +1,33 @@
+/*
+Package telegotest provides in-process fake Telegram Bot API server for end-to-end tests of Telego bots.
+
+Server implements Bot API methods commonly used by bots (getMe, getUpdates, sending text and media messages, editing
+and deleting messages, answering callback queries, getting chats and managing chat members) and keeps chats and
+messages in memory. Tests inject updates on behalf of users and then assert messages sent by the bot and resulting
+chat state.
+
+	srv := telegotest.NewServer()
+	defer srv.Close()
+
+	bot, _ := srv.Bot()
+	updates, _ := bot.UpdatesViaLongPolling(ctx, nil)
+	// Start bot handler ...
+
+	user := telego.User{ID: 1, FirstName: "Test"}
+	srv.SendUserMessage(telegotest.PrivateChat(user), user, "/start")
+
+	sent, err := srv.WaitSent(ctx, 1)
+	// Assert sent[0].Text ...
+
+Updates can also be built without the server using builders ([Text], [Caption], [Callback], [ChatMember] and
+[MyChatMember]) and passed directly to bot handler using [Updates]:
+
+	bh, _ := th.NewBotHandler(bot, telegotest.Updates(
+		telegotest.Text("/start ref").From(user).InGroup(chat).Update(),
+		telegotest.Callback("pressed").From(user).Update(),
+	))
+
+Note: Server doesn't validate all parameters as Telegram does, it's not a replacement for testing with real Telegram,
+but a fast and deterministic way to test bot logic.
+*/
+package telegotest
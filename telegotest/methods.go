@@ -0,0 +1,782 @@
+package telegotest
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mymmrac/telego"
+	"github.com/mymmrac/telego/internal/json"
+)
+
+// methodHandler handles API method call
+type methodHandler func(s *Server, ctx context.Context, params *requestParams) (any, error)
+
+// methodHandlers handlers of supported API methods
+var methodHandlers = map[string]methodHandler{
+	"getMe":                  (*Server).getMe,
+	"getUpdates":             (*Server).getUpdates,
+	"deleteWebhook":          (*Server).deleteWebhook,
+	"getWebhookInfo":         (*Server).getWebhookInfo,
+	"sendMessage":            (*Server).sendMessage,
+	"sendPhoto":              mediaHandler("photo"),
+	"sendDocument":           mediaHandler("document"),
+	"sendVideo":              mediaHandler("video"),
+	"sendAudio":              mediaHandler("audio"),
+	"sendAnimation":          mediaHandler("animation"),
+	"sendVoice":              mediaHandler("voice"),
+	"sendSticker":            mediaHandler("sticker"),
+	"sendChatAction":         (*Server).sendChatAction,
+	"editMessageText":        (*Server).editMessageText,
+	"editMessageCaption":     (*Server).editMessageCaption,
+	"editMessageReplyMarkup": (*Server).editMessageReplyMarkup,
+	"deleteMessage":          (*Server).deleteMessage,
+	"deleteMessages":         (*Server).deleteMessages,
+	"answerCallbackQuery":    (*Server).answerCallbackQuery,
+	"getChat":                (*Server).getChat,
+	"getChatMember":          (*Server).getChatMember,
+	"getChatMemberCount":     (*Server).getChatMemberCount,
+	"getChatAdministrators":  (*Server).getChatAdministrators,
+	"banChatMember":          (*Server).banChatMember,
+	"unbanChatMember":        (*Server).unbanChatMember,
+	"leaveChat":              (*Server).leaveChat,
+	"getFile":                (*Server).getFile,
+}
+
+func (s *Server) getMe(_ context.Context, _ *requestParams) (any, error) {
+	return s.me, nil
+}
+
+type getUpdatesParams struct {
+	Offset  int `json:"offset,omitempty"`
+	Limit   int `json:"limit,omitempty"`
+	Timeout int `json:"timeout,omitempty"`
+}
+
+func (s *Server) getUpdates(ctx context.Context, params *requestParams) (any, error) {
+	var p getUpdatesParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	limit := p.Limit
+	if limit <= 0 || limit > defaultUpdatesLimit {
+		limit = defaultUpdatesLimit
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(p.Timeout)*time.Second)
+	defer cancel()
+
+	for {
+		s.lock.Lock()
+		if p.Offset > 0 {
+			confirmed := 0
+			for confirmed < len(s.updates) && s.updates[confirmed].UpdateID < p.Offset {
+				confirmed++
+			}
+			s.updates = s.updates[confirmed:]
+		}
+
+		updates := append([]telego.Update{}, s.updates[:min(limit, len(s.updates))]...)
+		changed := s.changed
+		s.lock.Unlock()
+
+		if len(updates) > 0 || p.Timeout <= 0 || !s.waitChange(timeoutCtx, changed) {
+			return updates, nil
+		}
+	}
+}
+
+func (s *Server) deleteWebhook(_ context.Context, _ *requestParams) (any, error) {
+	return true, nil
+}
+
+func (s *Server) getWebhookInfo(_ context.Context, _ *requestParams) (any, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return telego.WebhookInfo{PendingUpdateCount: len(s.updates)}, nil
+}
+
+type sendParams struct {
+	ChatID          telego.ChatID           `json:"chat_id"`
+	Text            string                  `json:"text,omitempty"`
+	Caption         string                  `json:"caption,omitempty"`
+	ReplyMarkup     json.RawMessage         `json:"reply_markup,omitempty"`
+	ReplyParameters *telego.ReplyParameters `json:"reply_parameters,omitempty"`
+	Photo           string                  `json:"photo,omitempty"`
+	Document        string                  `json:"document,omitempty"`
+	Video           string                  `json:"video,omitempty"`
+	Audio           string                  `json:"audio,omitempty"`
+	Animation       string                  `json:"animation,omitempty"`
+	Voice           string                  `json:"voice,omitempty"`
+	Sticker         string                  `json:"sticker,omitempty"`
+}
+
+// reference returns file reference of media kind
+func (p *sendParams) reference(kind string) string {
+	switch kind {
+	case "photo":
+		return p.Photo
+	case "document":
+		return p.Document
+	case "video":
+		return p.Video
+	case "audio":
+		return p.Audio
+	case "animation":
+		return p.Animation
+	case "voice":
+		return p.Voice
+	case "sticker":
+		return p.Sticker
+	default:
+		return ""
+	}
+}
+
+func (s *Server) sendMessage(_ context.Context, params *requestParams) (any, error) {
+	var p sendParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	if p.Text == "" {
+		return nil, errBadRequest("message text is empty")
+	}
+
+	return s.send(&p, telego.Message{Text: p.Text})
+}
+
+// mediaHandler returns handler of method sending media of specified kind
+func mediaHandler(kind string) methodHandler {
+	return func(s *Server, _ context.Context, params *requestParams) (any, error) {
+		var p sendParams
+		if err := params.decode(&p); err != nil {
+			return nil, err
+		}
+
+		upload, reference, ok := params.file(kind, p.reference(kind))
+		if !ok {
+			return nil, errBadRequest("there is no " + kind + " in the request")
+		}
+
+		s.lock.Lock()
+		file, err := s.storeFile(kind, upload, reference)
+		s.lock.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		msg := telego.Message{Caption: p.Caption}
+		setMedia(&msg, kind, file, upload.name)
+		return s.send(&p, msg)
+	}
+}
+
+// storeFile stores uploaded file or resolves file reference, must be called with lock held
+func (s *Server) storeFile(kind string, upload uploadedFile, reference string) (telego.File, error) {
+	if reference != "" {
+		if stored, ok := s.files[reference]; ok {
+			return stored.file, nil
+		}
+		if !strings.HasPrefix(reference, "http://") && !strings.HasPrefix(reference, "https://") {
+			return telego.File{}, errBadRequest("wrong file identifier/HTTP URL specified")
+		}
+	}
+
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	file := telego.File{
+		FileID:       "file-" + id,
+		FileUniqueID: "unique-" + id,
+		FileSize:     int64(len(upload.data)),
+		FilePath:     kind + "s/file_" + id + path.Ext(upload.name),
+	}
+	s.files[file.FileID] = storedFile{file: file, data: upload.data}
+	return file, nil
+}
+
+// setMedia sets media of specified kind to message
+func setMedia(msg *telego.Message, kind string, file telego.File, name string) {
+	switch kind {
+	case "photo":
+		msg.Photo = []telego.PhotoSize{{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			FileSize:     int(file.FileSize),
+		}}
+	case "document":
+		msg.Document = &telego.Document{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			FileName:     name,
+			FileSize:     file.FileSize,
+		}
+	case "video":
+		msg.Video = &telego.Video{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			FileName:     name,
+			FileSize:     file.FileSize,
+		}
+	case "audio":
+		msg.Audio = &telego.Audio{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			FileName:     name,
+			FileSize:     file.FileSize,
+		}
+	case "animation":
+		msg.Animation = &telego.Animation{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			FileName:     name,
+			FileSize:     file.FileSize,
+		}
+	case "voice":
+		msg.Voice = &telego.Voice{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			FileSize:     file.FileSize,
+		}
+	case "sticker":
+		msg.Sticker = &telego.Sticker{
+			FileID:       file.FileID,
+			FileUniqueID: file.FileUniqueID,
+			Type:         telego.StickerTypeRegular,
+			FileSize:     int(file.FileSize),
+		}
+	}
+}
+
+// send stores message sent by the bot
+func (s *Server) send(p *sendParams, msg telego.Message) (any, error) {
+	markup, err := inlineMarkup(p.ReplyMarkup)
+	if err != nil {
+		return nil, err
+	}
+	msg.ReplyMarkup = markup
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.writableChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.ReplyParameters != nil {
+		replyTo, ok := state.messages[p.ReplyParameters.MessageID]
+		switch {
+		case ok:
+			msg.ReplyToMessage = cloneMessage(replyTo)
+		case !p.ReplyParameters.AllowSendingWithoutReply:
+			return nil, errBadRequest("message to be replied not found")
+		}
+	}
+
+	me := s.me
+	msg.From = &me
+	stored := s.newMessage(state, msg)
+	s.sent = append(s.sent, *cloneMessage(stored))
+	s.notify()
+
+	return stored, nil
+}
+
+// inlineMarkup decodes reply markup, other than inline keyboard markups are ignored since they are not attached to
+// the message
+func inlineMarkup(data json.RawMessage) (*telego.InlineKeyboardMarkup, error) {
+	if len(data) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	var markup telego.InlineKeyboardMarkup
+	if err := json.Unmarshal(data, &markup); err != nil {
+		return nil, errBadRequest("can't parse reply keyboard markup JSON object")
+	}
+
+	if len(markup.InlineKeyboard) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	return &markup, nil
+}
+
+// findChat returns state of existing chat, must be called with lock held
+func (s *Server) findChat(chatID telego.ChatID) (*chatState, error) {
+	if chatID.Username != "" {
+		for _, state := range s.chats {
+			if "@"+state.chat.Username == chatID.Username {
+				return state, nil
+			}
+		}
+		return nil, errBadRequest("chat not found")
+	}
+
+	state, ok := s.chats[chatID.ID]
+	if !ok {
+		return nil, errBadRequest("chat not found")
+	}
+	return state, nil
+}
+
+// writableChat returns state of chat where the bot can send messages, must be called with lock held
+func (s *Server) writableChat(chatID telego.ChatID) (*chatState, error) {
+	state, err := s.findChat(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.chat.Type == telego.ChatTypePrivate {
+		if _, blocked := s.blocked[state.chat.ID]; blocked {
+			return nil, errForbidden("bot was blocked by the user")
+		}
+		return state, nil
+	}
+
+	if state.botLeft {
+		return nil, errForbidden("bot was kicked from the " + state.chat.Type + " chat")
+	}
+	return state, nil
+}
+
+func (s *Server) sendChatAction(_ context.Context, params *requestParams) (any, error) {
+	var p sendParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.writableChat(p.ChatID); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+type editParams struct {
+	ChatID          telego.ChatID   `json:"chat_id"`
+	MessageID       int             `json:"message_id"`
+	InlineMessageID string          `json:"inline_message_id,omitempty"`
+	Text            string          `json:"text,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	ReplyMarkup     json.RawMessage `json:"reply_markup,omitempty"`
+}
+
+func (s *Server) editMessageText(_ context.Context, params *requestParams) (any, error) {
+	return s.edit(params, func(msg *telego.Message, p *editParams) error {
+		if msg.Text == "" {
+			return errBadRequest("there is no text in the message to edit")
+		}
+		if p.Text == "" {
+			return errBadRequest("message text is empty")
+		}
+
+		msg.Text = p.Text
+		return nil
+	})
+}
+
+func (s *Server) editMessageCaption(_ context.Context, params *requestParams) (any, error) {
+	return s.edit(params, func(msg *telego.Message, p *editParams) error {
+		if msg.Text != "" {
+			return errBadRequest("there is no caption in the message to edit")
+		}
+
+		msg.Caption = p.Caption
+		return nil
+	})
+}
+
+func (s *Server) editMessageReplyMarkup(_ context.Context, params *requestParams) (any, error) {
+	return s.edit(params, func(_ *telego.Message, _ *editParams) error {
+		return nil
+	})
+}
+
+// edit applies edit to message sent by the bot, reply markup is always replaced
+func (s *Server) edit(params *requestParams, apply func(msg *telego.Message, p *editParams) error) (any, error) {
+	var p editParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	if p.InlineMessageID != "" {
+		return nil, errBadRequest("inline messages are not supported by test server")
+	}
+
+	markup, err := inlineMarkup(p.ReplyMarkup)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, ok := state.messages[p.MessageID]
+	if !ok {
+		return nil, errBadRequest("message to edit not found")
+	}
+	if stored.From == nil || stored.From.ID != s.me.ID {
+		return nil, errBadRequest("message can't be edited")
+	}
+
+	edited := cloneMessage(stored)
+	if err = apply(edited, &p); err != nil {
+		return nil, err
+	}
+	edited.ReplyMarkup = markup
+
+	before, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+	after, err := json.Marshal(edited)
+	if err != nil {
+		return nil, err
+	}
+	if string(before) == string(after) {
+		return nil, errBadRequest("message is not modified: specified new message content and reply markup " +
+			"are exactly the same as a current content and reply markup of the message")
+	}
+
+	edited.EditDate = time.Now().Unix()
+	state.messages[p.MessageID] = edited
+	s.notify()
+
+	return edited, nil
+}
+
+type deleteParams struct {
+	ChatID     telego.ChatID `json:"chat_id"`
+	MessageID  int           `json:"message_id,omitempty"`
+	MessageIDs []int         `json:"message_ids,omitempty"`
+}
+
+func (s *Server) deleteMessage(_ context.Context, params *requestParams) (any, error) {
+	var p deleteParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := state.messages[p.MessageID]; !ok {
+		return nil, errBadRequest("message to delete not found")
+	}
+
+	delete(state.messages, p.MessageID)
+	s.notify()
+	return true, nil
+}
+
+func (s *Server) deleteMessages(_ context.Context, params *requestParams) (any, error) {
+	var p deleteParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like Telegram, messages that can't be found are skipped
+	for _, messageID := range p.MessageIDs {
+		delete(state.messages, messageID)
+	}
+	s.notify()
+	return true, nil
+}
+
+func (s *Server) answerCallbackQuery(_ context.Context, params *requestParams) (any, error) {
+	var answer CallbackAnswer
+	if err := params.decode(&answer); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.queries[answer.CallbackQueryID]; !ok {
+		return nil, errBadRequest("query is too old and response timeout expired or query ID is invalid")
+	}
+
+	delete(s.queries, answer.CallbackQueryID)
+	s.answers = append(s.answers, answer)
+	s.notify()
+	return true, nil
+}
+
+type chatParams struct {
+	ChatID       telego.ChatID `json:"chat_id"`
+	UserID       int64         `json:"user_id,omitempty"`
+	UntilDate    int64         `json:"until_date,omitempty"`
+	OnlyIfBanned bool          `json:"only_if_banned,omitempty"`
+}
+
+func (s *Server) getChat(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	return telego.ChatFullInfo{
+		ID:               state.chat.ID,
+		Type:             state.chat.Type,
+		Title:            state.chat.Title,
+		Username:         state.chat.Username,
+		FirstName:        state.chat.FirstName,
+		LastName:         state.chat.LastName,
+		IsForum:          state.chat.IsForum,
+		MaxReactionCount: 11,
+	}, nil
+}
+
+// member returns member of chat, the bot is a member of chats it didn't leave, must be called with lock held
+func (s *Server) member(state *chatState, userID int64) (telego.ChatMember, bool) {
+	if member, ok := state.members[userID]; ok {
+		return member, true
+	}
+
+	if userID == s.me.ID {
+		if state.botLeft {
+			return &telego.ChatMemberLeft{Status: telego.MemberStatusLeft, User: s.me}, true
+		}
+		return &telego.ChatMemberMember{Status: telego.MemberStatusMember, User: s.me}, true
+	}
+
+	if state.chat.Type == telego.ChatTypePrivate && userID == state.chat.ID {
+		return &telego.ChatMemberMember{Status: telego.MemberStatusMember, User: telego.User{
+			ID:        state.chat.ID,
+			FirstName: state.chat.FirstName,
+			LastName:  state.chat.LastName,
+			Username:  state.chat.Username,
+		}}, true
+	}
+
+	return nil, false
+}
+
+func (s *Server) getChatMember(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := s.member(state, p.UserID)
+	if !ok {
+		return nil, errBadRequest("user not found")
+	}
+	return member, nil
+}
+
+func (s *Server) getChatMemberCount(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.chat.Type == telego.ChatTypePrivate {
+		return 2, nil
+	}
+
+	count := 0
+	if _, ok := state.members[s.me.ID]; !ok && !state.botLeft {
+		count++
+	}
+	for _, member := range state.members {
+		if member.MemberIsMember() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *Server) getChatAdministrators(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	administrators := make([]telego.ChatMember, 0)
+	for _, member := range state.members {
+		status := member.MemberStatus()
+		if status == telego.MemberStatusCreator || status == telego.MemberStatusAdministrator {
+			administrators = append(administrators, member)
+		}
+	}
+	sort.Slice(administrators, func(i, j int) bool {
+		return administrators[i].MemberUser().ID < administrators[j].MemberUser().ID
+	})
+	return administrators, nil
+}
+
+// groupMember returns group chat and user for member management methods, must be called with lock held
+func (s *Server) groupMember(p *chatParams) (*chatState, telego.User, error) {
+	state, err := s.findChat(p.ChatID)
+	if err != nil {
+		return nil, telego.User{}, err
+	}
+
+	if state.chat.Type == telego.ChatTypePrivate {
+		return nil, telego.User{}, errBadRequest("method is available for supergroup and channel chats only")
+	}
+
+	member, ok := s.member(state, p.UserID)
+	if !ok {
+		return state, telego.User{ID: p.UserID}, nil
+	}
+
+	if member.MemberStatus() == telego.MemberStatusCreator {
+		return nil, telego.User{}, errBadRequest("can't remove chat owner")
+	}
+	return state, member.MemberUser(), nil
+}
+
+func (s *Server) banChatMember(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, user, err := s.groupMember(&p)
+	if err != nil {
+		return nil, err
+	}
+
+	state.members[user.ID] = &telego.ChatMemberBanned{
+		Status:    telego.MemberStatusBanned,
+		User:      user,
+		UntilDate: p.UntilDate,
+	}
+	s.notify()
+	return true, nil
+}
+
+func (s *Server) unbanChatMember(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, user, err := s.groupMember(&p)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like Telegram, unbanning current member removes it from the chat unless only banned members are unbanned
+	member, ok := state.members[user.ID]
+	banned := ok && member.MemberStatus() == telego.MemberStatusBanned
+	if !ok || (p.OnlyIfBanned && !banned) {
+		return true, nil
+	}
+
+	state.members[user.ID] = &telego.ChatMemberLeft{
+		Status: telego.MemberStatusLeft,
+		User:   user,
+	}
+	s.notify()
+	return true, nil
+}
+
+func (s *Server) leaveChat(_ context.Context, params *requestParams) (any, error) {
+	var p chatParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, err := s.writableChat(p.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.chat.Type == telego.ChatTypePrivate {
+		return nil, errBadRequest("chat member status can't be changed in private chats")
+	}
+
+	state.botLeft = true
+	delete(state.members, s.me.ID)
+	s.notify()
+	return true, nil
+}
+
+type getFileParams struct {
+	FileID string `json:"file_id"`
+}
+
+func (s *Server) getFile(_ context.Context, params *requestParams) (any, error) {
+	var p getFileParams
+	if err := params.decode(&p); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stored, ok := s.files[p.FileID]
+	if !ok {
+		return nil, errBadRequest("invalid file_id")
+	}
+	return stored.file, nil
+}
@@ -0,0 +1,655 @@
+package telegotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mymmrac/telego"
+	"github.com/mymmrac/telego/internal/json"
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+// Token bot token accepted by the server
+const Token = "1234567890:aaaabbbbaaaabbbbaaaabbbbaaaabbbbccc"
+
+// BotID ID of the bot user (first part of [Token])
+const BotID = 1234567890
+
+const (
+	// botPathPrefix prefix of API method paths
+	botPathPrefix = "/bot" + Token + "/"
+
+	// filePathPrefix prefix of file download paths
+	filePathPrefix = "/file/bot" + Token + "/"
+
+	// defaultUpdatesLimit default limit of updates returned by getUpdates
+	defaultUpdatesLimit = 100
+)
+
+// Server represents fake Telegram Bot API server
+type Server struct {
+	// URL - Base URL of the server (without trailing slash), use it with [telego.WithAPIServer]
+	URL string
+
+	srv *httptest.Server
+	me  telego.User
+
+	lock     sync.Mutex
+	changed  chan struct{}
+	closed   chan struct{}
+	chats    map[int64]*chatState
+	updates  []telego.Update
+	updateID int
+	sent     []telego.Message
+	calls    []string
+	answers  []CallbackAnswer
+	queries  map[string]struct{}
+	files    map[string]storedFile
+	blocked  map[int64]struct{}
+	nextID   int
+}
+
+// chatState represents state of one chat
+type chatState struct {
+	chat          telego.Chat
+	members       map[int64]telego.ChatMember
+	messages      map[int]*telego.Message
+	nextMessageID int
+	botLeft       bool
+}
+
+// storedFile represents file uploaded to the server
+type storedFile struct {
+	file telego.File
+	data []byte
+}
+
+// CallbackAnswer represents answer to callback query sent by the bot
+type CallbackAnswer struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`
+	ShowAlert       bool   `json:"show_alert,omitempty"`
+	URL             string `json:"url,omitempty"`
+	CacheTime       int    `json:"cache_time,omitempty"`
+}
+
+// NewServer starts new fake Bot API server, it should be closed using [Server.Close] once test is done
+func NewServer() *Server {
+	s := &Server{
+		me:      BotUser(),
+		changed: make(chan struct{}),
+		closed:  make(chan struct{}),
+		chats:   make(map[int64]*chatState),
+		queries: make(map[string]struct{}),
+		files:   make(map[string]storedFile),
+		blocked: make(map[int64]struct{}),
+	}
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close stops the server, pending long polling requests are finished immediately
+func (s *Server) Close() {
+	s.lock.Lock()
+	select {
+	case <-s.closed:
+	default:
+		close(s.closed)
+	}
+	s.lock.Unlock()
+
+	s.srv.Close()
+}
+
+// Bot creates bot that uses the server, options are applied after the default ones
+func (s *Server) Bot(options ...telego.BotOption) (*telego.Bot, error) {
+	return telego.NewBot(Token, append([]telego.BotOption{
+		telego.WithAPIServer(s.URL),
+		telego.WithDiscardLogger(),
+	}, options...)...)
+}
+
+// Me returns bot user
+func (s *Server) Me() telego.User {
+	return s.me
+}
+
+// PrivateChat returns private chat with user
+func PrivateChat(user telego.User) telego.Chat {
+	return telego.Chat{
+		ID:        user.ID,
+		Type:      telego.ChatTypePrivate,
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}
+}
+
+// notify wakes up everyone waiting for state changes, must be called with lock held
+func (s *Server) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// waitChange waits for state change, returns false if context is done or server is closed
+func (s *Server) waitChange(ctx context.Context, changed <-chan struct{}) bool {
+	select {
+	case <-changed:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-s.closed:
+		return false
+	}
+}
+
+// chat returns state of chat creating it if needed, must be called with lock held
+func (s *Server) chat(chat telego.Chat) *chatState {
+	state, ok := s.chats[chat.ID]
+	if !ok {
+		state = &chatState{
+			chat:     chat,
+			members:  make(map[int64]telego.ChatMember),
+			messages: make(map[int]*telego.Message),
+		}
+		s.chats[chat.ID] = state
+	}
+	return state
+}
+
+// addMember adds member to chat if it's not a member yet, must be called with lock held
+func (s *Server) addMember(state *chatState, user telego.User) {
+	if _, ok := state.members[user.ID]; ok {
+		return
+	}
+	state.members[user.ID] = &telego.ChatMemberMember{
+		Status: telego.MemberStatusMember,
+		User:   user,
+	}
+}
+
+// newMessage stores new message in chat, must be called with lock held
+func (s *Server) newMessage(state *chatState, msg telego.Message) *telego.Message {
+	state.nextMessageID++
+	msg.MessageID = state.nextMessageID
+	msg.Chat = state.chat
+	if msg.Date == 0 {
+		msg.Date = time.Now().Unix()
+	}
+
+	stored := &msg
+	state.messages[msg.MessageID] = stored
+	return stored
+}
+
+// pushUpdate adds update to the queue, must be called with lock held
+func (s *Server) pushUpdate(update telego.Update) telego.Update {
+	s.updateID++
+	update.UpdateID = s.updateID
+	s.updates = append(s.updates, update)
+	s.notify()
+	return update
+}
+
+// AddChat registers chat (for example, group where the bot is a member), existing chat is replaced
+func (s *Server) AddChat(chat telego.Chat) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := s.chat(chat)
+	state.chat = chat
+}
+
+// SetChatMember sets member of chat, chat must be registered first or it will be created with only ID set
+func (s *Server) SetChatMember(chatID int64, member telego.ChatMember) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := s.chat(telego.Chat{ID: chatID})
+	state.members[member.MemberUser().ID] = member
+}
+
+// BlockBot makes user block the bot, messages sent to user fail with "bot was blocked by the user" error
+func (s *Server) BlockBot(userID int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.blocked[userID] = struct{}{}
+}
+
+// SendUserMessage sends text message from user to chat and queues it as an update, entities are detected
+// automatically (see [TextEntities])
+func (s *Server) SendUserMessage(chat telego.Chat, from telego.User, text string) telego.Message {
+	return s.SendUserMessageWith(chat, from, telego.Message{Text: text, Entities: TextEntities(text)})
+}
+
+// SendUserMessageWith sends message from user to chat and queues it as an update, message ID, chat, sender and date
+// (if not set) are filled by the server
+func (s *Server) SendUserMessageWith(chat telego.Chat, from telego.User, msg telego.Message) telego.Message {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := s.chat(chat)
+	if chat.Type != telego.ChatTypePrivate {
+		s.addMember(state, from)
+	}
+
+	msg.From = &from
+	stored := s.newMessage(state, msg)
+	s.pushUpdate(telego.Update{Message: cloneMessage(stored)})
+	return *cloneMessage(stored)
+}
+
+// PressButton presses inline keyboard button with callback data attached to the message on behalf of user and
+// queues callback query update, returns callback query ID
+func (s *Server) PressButton(msg telego.Message, from telego.User, data string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.nextID++
+	queryID := strconv.Itoa(s.nextID)
+	s.queries[queryID] = struct{}{}
+
+	message := cloneMessage(&msg)
+	if state, ok := s.chats[msg.Chat.ID]; ok {
+		if stored, found := state.messages[msg.MessageID]; found {
+			message = cloneMessage(stored)
+		}
+	}
+
+	s.pushUpdate(telego.Update{
+		CallbackQuery: &telego.CallbackQuery{
+			ID:           queryID,
+			From:         from,
+			Message:      message,
+			ChatInstance: strconv.FormatInt(msg.Chat.ID, 10),
+			Data:         data,
+		},
+	})
+	return queryID
+}
+
+// InjectUpdate queues arbitrary update, update ID is assigned by the server
+func (s *Server) InjectUpdate(update telego.Update) telego.Update {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pushUpdate(update)
+}
+
+// PendingUpdates returns number of updates not yet confirmed by the bot
+func (s *Server) PendingUpdates() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.updates)
+}
+
+// Sent returns all messages sent by the bot in order of sending (as they were sent, without later edits)
+func (s *Server) Sent() []telego.Message {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]telego.Message(nil), s.sent...)
+}
+
+// WaitSent waits until the bot sends at least count messages in total and returns all sent messages
+func (s *Server) WaitSent(ctx context.Context, count int) ([]telego.Message, error) {
+	for {
+		s.lock.Lock()
+		sent := append([]telego.Message(nil), s.sent...)
+		changed := s.changed
+		s.lock.Unlock()
+
+		if len(sent) >= count {
+			return sent, nil
+		}
+
+		if !s.waitChange(ctx, changed) {
+			if ctx.Err() != nil {
+				return sent, fmt.Errorf("wait for %d sent messages, got %d: %w", count, len(sent), ctx.Err())
+			}
+			return sent, errors.New("server closed")
+		}
+	}
+}
+
+// Messages returns current messages of chat ordered by message ID (deleted messages are excluded and edits applied)
+func (s *Server) Messages(chatID int64) []telego.Message {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.chats[chatID]
+	if !ok {
+		return nil
+	}
+
+	messages := make([]telego.Message, 0, len(state.messages))
+	for _, msg := range state.messages {
+		messages = append(messages, *cloneMessage(msg))
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].MessageID < messages[j].MessageID
+	})
+	return messages
+}
+
+// Message returns current state of chat message
+func (s *Server) Message(chatID int64, messageID int) (telego.Message, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.chats[chatID]
+	if !ok {
+		return telego.Message{}, false
+	}
+
+	msg, ok := state.messages[messageID]
+	if !ok {
+		return telego.Message{}, false
+	}
+	return *cloneMessage(msg), true
+}
+
+// Chat returns registered chat
+func (s *Server) Chat(chatID int64) (telego.Chat, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.chats[chatID]
+	if !ok {
+		return telego.Chat{}, false
+	}
+	return state.chat, true
+}
+
+// ChatMember returns member of chat
+func (s *Server) ChatMember(chatID, userID int64) (telego.ChatMember, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.chats[chatID]
+	if !ok {
+		return nil, false
+	}
+
+	member, ok := state.members[userID]
+	return member, ok
+}
+
+// CallbackAnswers returns all answers to callback queries sent by the bot
+func (s *Server) CallbackAnswers() []CallbackAnswer {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]CallbackAnswer(nil), s.answers...)
+}
+
+// Calls returns names of all API methods called by the bot in order of calling
+func (s *Server) Calls() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string(nil), s.calls...)
+}
+
+// cloneMessage returns deep enough copy of message to not share mutable state
+func cloneMessage(msg *telego.Message) *telego.Message {
+	clone := *msg
+	return &clone
+}
+
+// apiError represents error returned by the server
+type apiError struct {
+	code        int
+	description string
+}
+
+func (e *apiError) Error() string {
+	return e.description
+}
+
+// Errors returned by the server
+func errBadRequest(description string) *apiError {
+	return &apiError{code: http.StatusBadRequest, description: "Bad Request: " + description}
+}
+
+func errForbidden(description string) *apiError {
+	return &apiError{code: http.StatusForbidden, description: "Forbidden: " + description}
+}
+
+// serveHTTP serves API requests
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, botPathPrefix):
+		s.serveMethod(w, r, strings.TrimPrefix(r.URL.Path, botPathPrefix))
+	case strings.HasPrefix(r.URL.Path, filePathPrefix):
+		s.serveFile(w, r, strings.TrimPrefix(r.URL.Path, filePathPrefix))
+	case strings.HasPrefix(r.URL.Path, "/bot"), strings.HasPrefix(r.URL.Path, "/file/bot"):
+		writeResponse(w, nil, &apiError{code: http.StatusUnauthorized, description: "Unauthorized"})
+	default:
+		writeResponse(w, nil, &apiError{code: http.StatusNotFound, description: "Not Found"})
+	}
+}
+
+// serveMethod serves API method call
+func (s *Server) serveMethod(w http.ResponseWriter, r *http.Request, method string) {
+	params, err := parseRequest(r)
+	if err != nil {
+		writeResponse(w, nil, errBadRequest(err.Error()))
+		return
+	}
+
+	s.lock.Lock()
+	s.calls = append(s.calls, method)
+	s.lock.Unlock()
+
+	handler, ok := methodHandlers[method]
+	if !ok {
+		writeResponse(w, nil, &apiError{code: http.StatusNotFound, description: "Not Found: method not found"})
+		return
+	}
+
+	result, err := handler(s, r.Context(), params)
+	writeResponse(w, result, err)
+}
+
+// serveFile serves file download
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request, filePath string) {
+	s.lock.Lock()
+	var stored *storedFile
+	for _, file := range s.files {
+		if file.file.FilePath == filePath {
+			stored = &file
+			break
+		}
+	}
+	s.lock.Unlock()
+
+	if stored == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	http.ServeContent(w, r, filePath, time.Time{}, strings.NewReader(string(stored.data)))
+}
+
+// writeResponse writes API response
+func writeResponse(w http.ResponseWriter, result any, err error) {
+	resp := ta.Response{Ok: err == nil}
+	statusCode := http.StatusOK
+
+	if err != nil {
+		var apiErr *apiError
+		if !errors.As(err, &apiErr) {
+			apiErr = &apiError{code: http.StatusInternalServerError, description: "Internal Server Error: " + err.Error()}
+		}
+
+		statusCode = apiErr.code
+		resp.Error = &ta.Error{ErrorCode: apiErr.code, Description: apiErr.description}
+	} else {
+		data, marshalErr := json.Marshal(result)
+		if marshalErr != nil {
+			writeResponse(w, nil, marshalErr)
+			return
+		}
+		resp.Result = data
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(ta.ContentTypeHeader, ta.ContentTypeJSON)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
+
+// uploadedFile represents file sent in multipart request
+type uploadedFile struct {
+	name string
+	data []byte
+}
+
+// requestParams represents parameters of API request
+type requestParams struct {
+	body   []byte
+	fields map[string]string
+	files  map[string]uploadedFile
+}
+
+// parseRequest parses JSON or multipart request
+func parseRequest(r *http.Request) (*requestParams, error) {
+	params := &requestParams{}
+
+	contentType := r.Header.Get(ta.ContentTypeHeader)
+	if contentType == "" {
+		return params, nil
+	}
+
+	mediaType, mediaParams, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type: %w", err)
+	}
+
+	switch mediaType {
+	case ta.ContentTypeJSON:
+		params.body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("read body: %w", err)
+		}
+		return params, nil
+	case "multipart/form-data":
+		params.fields = make(map[string]string)
+		params.files = make(map[string]uploadedFile)
+
+		reader := multipart.NewReader(r.Body, mediaParams["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				return params, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("read multipart: %w", err)
+			}
+
+			data, err := io.ReadAll(part)
+			if err != nil {
+				return nil, fmt.Errorf("read multipart: %w", err)
+			}
+
+			if part.FileName() != "" {
+				params.files[part.FormName()] = uploadedFile{name: part.FileName(), data: data}
+			} else {
+				params.fields[part.FormName()] = string(data)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content type: %q", mediaType)
+	}
+}
+
+// decode decodes request parameters into struct, multipart fields are converted according to struct field types
+func (p *requestParams) decode(v any) error {
+	data := p.body
+	if p.fields != nil {
+		var err error
+		data, err = formToJSON(p.fields, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("can't parse parameters: %w", err)
+	}
+	return nil
+}
+
+// formToJSON converts form fields to JSON object, string fields are quoted, others are used as is if valid JSON
+func formToJSON(fields map[string]string, v any) ([]byte, error) {
+	stringFields := make(map[string]bool)
+
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		stringFields[name] = field.Type.Kind() == reflect.String
+	}
+
+	object := make(map[string]json.RawMessage, len(fields))
+	for name, value := range fields {
+		if !stringFields[name] && validJSON(value) {
+			object[name] = json.RawMessage(value)
+			continue
+		}
+
+		quoted, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		object[name] = quoted
+	}
+
+	return json.Marshal(object)
+}
+
+// validJSON reports whether value is valid JSON
+func validJSON(value string) bool {
+	var v any
+	return json.Unmarshal([]byte(value), &v) == nil
+}
+
+// file returns uploaded file or file reference (file ID or URL) of parameter
+func (p *requestParams) file(name, reference string) (uploadedFile, string, bool) {
+	if file, ok := p.files[name]; ok {
+		return file, "", true
+	}
+
+	if strings.HasPrefix(reference, "attach://") {
+		file, ok := p.files[strings.TrimPrefix(reference, "attach://")]
+		return file, "", ok
+	}
+
+	return uploadedFile{}, reference, reference != ""
+}
@@ -0,0 +1,351 @@
+package telegotest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/telego"
+	ta "github.com/mymmrac/telego/telegoapi"
+	tu "github.com/mymmrac/telego/telegoutil"
+)
+
+var (
+	user  = telego.User{ID: 1, FirstName: "User", Username: "user"}
+	group = telego.Chat{ID: -100, Type: telego.ChatTypeSupergroup, Title: "Group", Username: "group"}
+)
+
+func newTestBot(t *testing.T) (*Server, *telego.Bot) {
+	t.Helper()
+
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	bot, err := srv.Bot()
+	require.NoError(t, err)
+
+	return srv, bot
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestServer_EndToEnd(t *testing.T) {
+	srv, bot := newTestBot(t)
+	ctx := testContext(t)
+
+	updates, err := bot.UpdatesViaLongPolling(nil, telego.WithLongPollingContext(ctx))
+	require.NoError(t, err)
+
+	go func() {
+		for update := range updates {
+			switch {
+			case update.Message != nil && update.Message.Text == "/start":
+				_, _ = bot.SendMessage(ctx, tu.Message(tu.ID(update.Message.Chat.ID), "Hello").
+					WithReplyMarkup(tu.InlineKeyboard(tu.InlineKeyboardRow(
+						tu.InlineKeyboardButton("Press").WithCallbackData("pressed"),
+					))))
+			case update.CallbackQuery != nil:
+				_ = bot.AnswerCallbackQuery(ctx, tu.CallbackQuery(update.CallbackQuery.ID).WithText("Done"))
+				msg := update.CallbackQuery.Message
+				_, _ = bot.EditMessageText(ctx, &telego.EditMessageTextParams{
+					ChatID:    tu.ID(msg.GetChat().ID),
+					MessageID: msg.GetMessageID(),
+					Text:      "Pressed: " + update.CallbackQuery.Data,
+				})
+			}
+		}
+	}()
+
+	srv.SendUserMessage(PrivateChat(user), user, "/start")
+
+	sent, err := srv.WaitSent(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Hello", sent[0].Text)
+	assert.Equal(t, user.ID, sent[0].Chat.ID)
+	require.NotNil(t, sent[0].ReplyMarkup)
+	assert.Equal(t, "pressed", sent[0].ReplyMarkup.InlineKeyboard[0][0].CallbackData)
+
+	queryID := srv.PressButton(sent[0], user, "pressed")
+
+	require.Eventually(t, func() bool {
+		msg, ok := srv.Message(user.ID, sent[0].MessageID)
+		return ok && msg.Text == "Pressed: pressed"
+	}, time.Second*5, time.Millisecond*10)
+
+	assert.Equal(t, []CallbackAnswer{{CallbackQueryID: queryID, Text: "Done"}}, srv.CallbackAnswers())
+
+	messages := srv.Messages(user.ID)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "/start", messages[0].Text)
+	assert.Nil(t, messages[1].ReplyMarkup)
+	assert.NotZero(t, messages[1].EditDate)
+
+	assert.Contains(t, srv.Calls(), "getUpdates")
+}
+
+func TestServer_GetUpdates(t *testing.T) {
+	srv, bot := newTestBot(t)
+	ctx := testContext(t)
+
+	updates, err := bot.GetUpdates(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, updates)
+
+	srv.SendUserMessage(PrivateChat(user), user, "1")
+	srv.SendUserMessage(PrivateChat(user), user, "2")
+	injected := srv.InjectUpdate(telego.Update{EditedMessage: &telego.Message{Text: "3"}})
+	assert.Equal(t, 3, injected.UpdateID)
+
+	updates, err = bot.GetUpdates(ctx, &telego.GetUpdatesParams{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	assert.Equal(t, "1", updates[0].Message.Text)
+	assert.Equal(t, "2", updates[1].Message.Text)
+
+	updates, err = bot.GetUpdates(ctx, &telego.GetUpdatesParams{Offset: 3})
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	assert.Equal(t, "3", updates[0].EditedMessage.Text)
+	assert.Equal(t, 1, srv.PendingUpdates())
+
+	t.Run("wait", func(t *testing.T) {
+		go func() {
+			time.Sleep(time.Millisecond * 50)
+			srv.SendUserMessage(PrivateChat(user), user, "4")
+		}()
+
+		updates, err = bot.GetUpdates(ctx, &telego.GetUpdatesParams{Offset: 4, Timeout: 5})
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		assert.Equal(t, "4", updates[0].Message.Text)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		updates, err = bot.GetUpdates(ctx, &telego.GetUpdatesParams{Offset: 5, Timeout: 1})
+		require.NoError(t, err)
+		assert.Empty(t, updates)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+}
+
+func TestServer_Media(t *testing.T) {
+	srv, bot := newTestBot(t)
+	ctx := testContext(t)
+	chat := PrivateChat(user)
+	srv.AddChat(chat)
+
+	msg, err := bot.SendPhoto(ctx, tu.Photo(tu.ID(chat.ID), tu.File(tu.NameReader(strings.NewReader("photo"), "a.jpg"))).
+		WithCaption("Caption"))
+	require.NoError(t, err)
+	require.Len(t, msg.Photo, 1)
+	assert.Equal(t, "Caption", msg.Caption)
+	assert.EqualValues(t, 5, msg.Photo[0].FileSize)
+
+	doc, err := bot.SendDocument(ctx, tu.Document(tu.ID(chat.ID), tu.FileFromID(msg.Photo[0].FileID)))
+	require.NoError(t, err)
+	require.NotNil(t, doc.Document)
+	assert.Equal(t, msg.Photo[0].FileID, doc.Document.FileID)
+
+	file, err := bot.GetFile(ctx, &telego.GetFileParams{FileID: msg.Photo[0].FileID})
+	require.NoError(t, err)
+
+	resp, err := http.Get(bot.FileDownloadURL(file.FilePath))
+	require.NoError(t, err)
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "photo", string(data))
+
+	_, err = bot.SendSticker(ctx, tu.Sticker(tu.ID(chat.ID), tu.FileFromID("unknown")))
+	require.Error(t, err)
+
+	edited, err := bot.EditMessageCaption(ctx, &telego.EditMessageCaptionParams{
+		ChatID:    tu.ID(chat.ID),
+		MessageID: msg.MessageID,
+		Caption:   "New",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "New", edited.Caption)
+
+	_, err = bot.EditMessageText(ctx, &telego.EditMessageTextParams{
+		ChatID:    tu.ID(chat.ID),
+		MessageID: msg.MessageID,
+		Text:      "Text",
+	})
+	require.Error(t, err)
+
+	assert.Len(t, srv.Sent(), 2)
+	assert.Equal(t, "Caption", srv.Sent()[0].Caption)
+}
+
+func TestServer_Errors(t *testing.T) {
+	srv, bot := newTestBot(t)
+	ctx := testContext(t)
+
+	_, err := bot.SendMessage(ctx, tu.Message(tu.ID(user.ID), "Hello"))
+	assertAPIError(t, err, http.StatusBadRequest, "Bad Request: chat not found")
+
+	srv.AddChat(PrivateChat(user))
+	msg, err := bot.SendMessage(ctx, tu.Message(tu.ID(user.ID), "Hello"))
+	require.NoError(t, err)
+
+	_, err = bot.EditMessageText(ctx, &telego.EditMessageTextParams{
+		ChatID:    tu.ID(user.ID),
+		MessageID: msg.MessageID,
+		Text:      "Hello",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "message is not modified")
+
+	userMsg := srv.SendUserMessage(PrivateChat(user), user, "Hi")
+	_, err = bot.EditMessageText(ctx, &telego.EditMessageTextParams{
+		ChatID:    tu.ID(user.ID),
+		MessageID: userMsg.MessageID,
+		Text:      "Edited",
+	})
+	assertAPIError(t, err, http.StatusBadRequest, "Bad Request: message can't be edited")
+
+	require.NoError(t, bot.DeleteMessage(ctx, tu.Delete(tu.ID(user.ID), msg.MessageID)))
+	err = bot.DeleteMessage(ctx, tu.Delete(tu.ID(user.ID), msg.MessageID))
+	assertAPIError(t, err, http.StatusBadRequest, "Bad Request: message to delete not found")
+	assert.Len(t, srv.Messages(user.ID), 1)
+
+	err = bot.AnswerCallbackQuery(ctx, tu.CallbackQuery("unknown"))
+	require.Error(t, err)
+
+	srv.BlockBot(user.ID)
+	_, err = bot.SendMessage(ctx, tu.Message(tu.ID(user.ID), "Hello"))
+	assertAPIError(t, err, http.StatusForbidden, "Forbidden: bot was blocked by the user")
+
+	_, err = bot.GetStarTransactions(ctx, nil)
+	assertAPIError(t, err, http.StatusNotFound, "Not Found: method not found")
+}
+
+func TestServer_Chats(t *testing.T) {
+	srv, bot := newTestBot(t)
+	ctx := testContext(t)
+
+	owner := telego.User{ID: 2, FirstName: "Owner"}
+	srv.AddChat(group)
+	srv.SetChatMember(group.ID, &telego.ChatMemberOwner{Status: telego.MemberStatusCreator, User: owner})
+	srv.SendUserMessage(group, user, "Hi")
+
+	chat, err := bot.GetChat(ctx, &telego.GetChatParams{ChatID: tu.Username("@group")})
+	require.NoError(t, err)
+	assert.Equal(t, group.ID, chat.ID)
+	assert.Equal(t, group.Title, chat.Title)
+
+	count, err := bot.GetChatMemberCount(ctx, &telego.GetChatMemberCountParams{ChatID: tu.ID(group.ID)})
+	require.NoError(t, err)
+	assert.Equal(t, 3, *count)
+
+	admins, err := bot.GetChatAdministrators(ctx, &telego.GetChatAdministratorsParams{ChatID: tu.ID(group.ID)})
+	require.NoError(t, err)
+	require.Len(t, admins, 1)
+	assert.Equal(t, owner, admins[0].MemberUser())
+
+	member, err := bot.GetChatMember(ctx, &telego.GetChatMemberParams{ChatID: tu.ID(group.ID), UserID: user.ID})
+	require.NoError(t, err)
+	assert.Equal(t, telego.MemberStatusMember, member.MemberStatus())
+
+	require.NoError(t, bot.BanChatMember(ctx, &telego.BanChatMemberParams{ChatID: tu.ID(group.ID), UserID: user.ID}))
+	banned, ok := srv.ChatMember(group.ID, user.ID)
+	require.True(t, ok)
+	assert.Equal(t, telego.MemberStatusBanned, banned.MemberStatus())
+
+	err = bot.BanChatMember(ctx, &telego.BanChatMemberParams{ChatID: tu.ID(group.ID), UserID: owner.ID})
+	require.Error(t, err)
+
+	require.NoError(t, bot.UnbanChatMember(ctx, &telego.UnbanChatMemberParams{
+		ChatID: tu.ID(group.ID), UserID: user.ID, OnlyIfBanned: true,
+	}))
+	unbanned, ok := srv.ChatMember(group.ID, user.ID)
+	require.True(t, ok)
+	assert.Equal(t, telego.MemberStatusLeft, unbanned.MemberStatus())
+
+	require.NoError(t, bot.LeaveChat(ctx, &telego.LeaveChatParams{ChatID: tu.ID(group.ID)}))
+	_, err = bot.SendMessage(ctx, tu.Message(tu.ID(group.ID), "Hello"))
+	assertAPIError(t, err, http.StatusForbidden, "Forbidden: bot was kicked from the supergroup chat")
+}
+
+func TestServer_Close(t *testing.T) {
+	srv, bot := newTestBot(t)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = bot.GetUpdates(context.Background(), &telego.GetUpdatesParams{Timeout: 60})
+	}()
+
+	time.Sleep(time.Millisecond * 50)
+	srv.Close()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("long polling request was not finished")
+	}
+
+	_, err := srv.WaitSent(context.Background(), 1)
+	require.Error(t, err)
+}
+
+func assertAPIError(t *testing.T, err error, code int, description string) {
+	t.Helper()
+
+	var apiErr *ta.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, code, apiErr.ErrorCode)
+	assert.Equal(t, description, apiErr.Description)
+}
+
+func TestServer_LongPollingOffsetStore(t *testing.T) {
+	srv, bot := newTestBot(t)
+	ctx := testContext(t)
+	store := &telego.MemoryOffsetStore{}
+
+	srv.SendUserMessage(PrivateChat(user), user, "1")
+	srv.SendUserMessage(PrivateChat(user), user, "2")
+
+	updates, err := bot.UpdatesViaLongPolling(nil, telego.WithLongPollingOffsetStore(store),
+		telego.WithLongPollingContext(ctx))
+	require.NoError(t, err)
+
+	first := <-updates
+	second := <-updates
+	assert.Equal(t, "1", first.Message.Text)
+	assert.Equal(t, "2", second.Message.Text)
+
+	// Only the first update is processed before restart
+	first.Done()
+	bot.StopLongPolling()
+
+	updates, err = bot.UpdatesViaLongPolling(nil, telego.WithLongPollingOffsetStore(store),
+		telego.WithLongPollingContext(ctx))
+	require.NoError(t, err)
+	defer bot.StopLongPolling()
+
+	redelivered := <-updates
+	assert.Equal(t, second.UpdateID, redelivered.UpdateID)
+	assert.Equal(t, "2", redelivered.Message.Text)
+	assert.Equal(t, 1, srv.PendingUpdates())
+
+	redelivered.Done()
+	offset, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, second.UpdateID+1, offset)
+}
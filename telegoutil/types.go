@@ -37,6 +37,13 @@ func FileFromURL(url string) telego.InputFile {
 	}
 }
 
+// FileFromPath creates telego.InputFile from absolute path to file on disk of local Bot API server
+func FileFromPath(path string) telego.InputFile {
+	return telego.InputFile{
+		LocalPath: path,
+	}
+}
+
 // FileFromID creates telego.InputFile from file ID
 func FileFromID(id string) telego.InputFile {
 	return telego.InputFile{
@@ -45,6 +52,8 @@ func FileFromID(id string) telego.InputFile {
 }
 
 // DownloadFile returns downloaded file bytes or error
+// Note: File is downloaded using default fasthttp client, use [telego.Bot.DownloadFile] to download files using
+// bot's caller without reading the whole file into memory
 func DownloadFile(url string) ([]byte, error) {
 	var file []byte
 	status, file, err := fasthttp.Get(file, url)
@@ -40,6 +40,11 @@ func TestFileByURL(t *testing.T) {
 	assert.Equal(t, text1, f.URL)
 }
 
+func TestFileFromPath(t *testing.T) {
+	f := FileFromPath(text1)
+	assert.Equal(t, text1, f.LocalPath)
+}
+
 func TestDownloadFile(t *testing.T) {
 	expectedData := []byte("OK")
 	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
@@ -114,12 +114,44 @@ type Update struct {
 	// Value can't be cloned; thus, after calling [Update.Clone] or [Update.CloneSafe] ctx will be the same as in the
 	// original update.
 	ctx context.Context
+
+	// raw - Internal raw JSON of the update as received from Telegram, can be retrieved using [Update.Raw].
+	// Value is set only when update is decoded from JSON and is carried to copies as is.
+	raw json.RawMessage
+
+	// done - Internal callback called by [Update.Done], set by long polling with offset store and carried to copies
+	// as is.
+	done func()
+
+	// reply - Internal callback called by [Update.WebhookReply], set by webhook with replies enabled and carried to
+	// copies as is.
+	reply func(body []byte) bool
+}
+
+// UnmarshalJSON converts JSON to Update, raw JSON is kept and can be retrieved using [Update.Raw]
+func (u *Update) UnmarshalJSON(data []byte) error {
+	type uUpdate Update
+	uu := uUpdate{ctx: u.ctx}
+
+	if err := json.Unmarshal(data, &uu); err != nil {
+		return err
+	}
+	uu.raw = rawCopy(data)
+	*u = Update(uu)
+
+	return nil
+}
+
+// Raw returns the update's raw JSON as received from Telegram, useful for accessing fields that are not yet supported
+// by Telego. Returns nil if update was not decoded from JSON.
+func (u Update) Raw() json.RawMessage {
+	return u.raw
 }
 
 // Clone returns a deep copy of Update.
 //
-// Warning: Types like [ChatMember] and [MenuButton] require to have their mandatory fields (like status or type) to be
-// filled properly, else [Update.Clone] method will panic. To safely clone, use [Update.CloneSafe] method.
+// Warning: If update can't be marshaled to JSON and back (for example, if it contains values of types that don't
+// belong to Telego), [Update.Clone] method will panic. To safely clone, use [Update.CloneSafe] method.
 func (u Update) Clone() Update {
 	update, err := u.CloneSafe()
 	if err != nil {
@@ -131,7 +163,8 @@ func (u Update) Clone() Update {
 
 // CloneSafe returns a deep copy of Update or an error.
 //
-// Note: Update's context is carried to the copy as is, to change it use [Update.WithContext] method.
+// Note: Update's context and raw JSON are carried to the copy as is, to change context use [Update.WithContext]
+// method.
 func (u Update) CloneSafe() (Update, error) {
 	var update Update
 
@@ -145,10 +178,43 @@ func (u Update) CloneSafe() (Update, error) {
 		return Update{}, fmt.Errorf("telego: clone update: unmarshal: %w", err)
 	}
 	update.ctx = u.ctx
+	update.raw = u.raw
+	update.done = u.done
+	update.reply = u.reply
 
 	return update, nil
 }
 
+// Done marks update as processed, it's used by long polling with offset store (see [WithLongPollingOffsetStore]) to
+// commit offset of processed updates and by webhook with replies enabled (see [WithWebhookReplies]) to release
+// Telegram's request without waiting for reply timeout, otherwise does nothing.
+// Note: Bot handler from telegohandler package calls it automatically once update is handled, if updates are processed
+// manually, Done (or [Update.WebhookReply]) should be called for each update.
+func (u Update) Done() {
+	if u.done != nil {
+		u.done()
+	}
+}
+
+// WebhookReply answers webhook update with method call (like "sendMessage" with [SendMessageParams]) in HTTP
+// response, returns false if reply can't be sent this way. In that case, method should be called as usual.
+// Only one reply per update is possible and only if webhook replies are enabled (see [WithWebhookReplies]) and
+// reply timeout has not yet passed. Methods that upload files can't be used as replies.
+//
+// Warning: Result of method call is unknown when it is sent as webhook reply, and errors are not reported.
+func (u Update) WebhookReply(method string, params any) bool {
+	if u.reply == nil {
+		return false
+	}
+
+	body, err := webhookReplyBody(method, params)
+	if err != nil {
+		return false
+	}
+
+	return u.reply(body)
+}
+
 // Context returns the update's context. To change the context, use WithContext.
 // The returned context is always non-nil; it defaults to the background context.
 func (u Update) Context() context.Context {
@@ -171,6 +237,61 @@ func (u Update) WithContext(ctx context.Context) Update {
 	return u
 }
 
+// Type returns the type of update as named in JSON (like "message" or "callback_query"), empty string if update
+// has no known fields set
+func (u Update) Type() string {
+	switch {
+	case u.Message != nil:
+		return "message"
+	case u.EditedMessage != nil:
+		return "edited_message"
+	case u.ChannelPost != nil:
+		return "channel_post"
+	case u.EditedChannelPost != nil:
+		return "edited_channel_post"
+	case u.BusinessConnection != nil:
+		return "business_connection"
+	case u.BusinessMessage != nil:
+		return "business_message"
+	case u.EditedBusinessMessage != nil:
+		return "edited_business_message"
+	case u.DeletedBusinessMessages != nil:
+		return "deleted_business_messages"
+	case u.MessageReaction != nil:
+		return "message_reaction"
+	case u.MessageReactionCount != nil:
+		return "message_reaction_count"
+	case u.InlineQuery != nil:
+		return "inline_query"
+	case u.ChosenInlineResult != nil:
+		return "chosen_inline_result"
+	case u.CallbackQuery != nil:
+		return "callback_query"
+	case u.ShippingQuery != nil:
+		return "shipping_query"
+	case u.PreCheckoutQuery != nil:
+		return "pre_checkout_query"
+	case u.PurchasedPaidMedia != nil:
+		return "purchased_paid_media"
+	case u.Poll != nil:
+		return "poll"
+	case u.PollAnswer != nil:
+		return "poll_answer"
+	case u.MyChatMember != nil:
+		return "my_chat_member"
+	case u.ChatMember != nil:
+		return "chat_member"
+	case u.ChatJoinRequest != nil:
+		return "chat_join_request"
+	case u.ChatBoost != nil:
+		return "chat_boost"
+	case u.RemovedChatBoost != nil:
+		return "removed_chat_boost"
+	default:
+		return ""
+	}
+}
+
 // WebhookInfo - Describes the current status of a webhook.
 type WebhookInfo struct {
 	// URL - Webhook URL, may be empty if webhook is not set up
@@ -465,9 +586,6 @@ type ChatFullInfo struct {
 	Location *ChatLocation `json:"location,omitempty"`
 }
 
-// unknownReactionTypeErr is an error for unknown reaction type
-const unknownReactionTypeErr = "unknown reaction type: %s"
-
 // UnmarshalJSON converts JSON to Chat
 func (c *ChatFullInfo) UnmarshalJSON(data []byte) error {
 	parser := json.ParserPoll.Get()
@@ -494,7 +612,7 @@ func (c *ChatFullInfo) UnmarshalJSON(data []byte) error {
 			case ReactionPaid:
 				uc.AvailableReactions[i] = &ReactionTypePaid{}
 			default:
-				return fmt.Errorf(unknownReactionTypeErr, reactionType)
+				uc.AvailableReactions[i] = &UnknownReactionType{}
 			}
 		}
 	}
@@ -846,7 +964,7 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		case OriginTypeChannel:
 			um.ForwardOrigin = &MessageOriginChannel{}
 		default:
-			return fmt.Errorf("unknown forward message origin: %s", forwardOriginType)
+			um.ForwardOrigin = &UnknownMessageOrigin{}
 		}
 	}
 
@@ -1124,7 +1242,7 @@ func (e *ExternalReplyInfo) UnmarshalJSON(data []byte) error {
 	case OriginTypeChannel:
 		ue.Origin = &MessageOriginChannel{}
 	default:
-		return fmt.Errorf("unknown origin: %s", originType)
+		ue.Origin = &UnknownMessageOrigin{}
 	}
 
 	if err = json.Unmarshal(data, &ue); err != nil {
@@ -1530,7 +1648,7 @@ func (m *PaidMediaInfo) UnmarshalJSON(data []byte) error {
 			case PaidMediaTypeVideo:
 				um.PaidMedia[i] = &PaidMediaVideo{}
 			default:
-				return fmt.Errorf("unknown paid media type: %s", mediaType)
+				um.PaidMedia[i] = &UnknownPaidMedia{}
 			}
 		}
 	}
@@ -1977,7 +2095,7 @@ func (b *BackgroundTypeFill) UnmarshalJSON(data []byte) error {
 	case BackgroundFilledFreeformGradient:
 		ub.Fill = &BackgroundFillFreeformGradient{}
 	default:
-		return fmt.Errorf("unknown chat background fill type: %s", fillType)
+		ub.Fill = &UnknownBackgroundFill{}
 	}
 
 	if err = json.Unmarshal(data, &ub); err != nil {
@@ -2044,6 +2162,43 @@ func (b *BackgroundTypePattern) BackgroundType() string {
 
 func (b *BackgroundTypePattern) iBackgroundType() {}
 
+// UnmarshalJSON converts JSON to BackgroundTypePattern
+func (b *BackgroundTypePattern) UnmarshalJSON(data []byte) error {
+	parser := json.ParserPoll.Get()
+	defer json.ParserPoll.Put(parser)
+
+	value, err := parser.ParseBytes(data)
+	if err != nil {
+		return err
+	}
+
+	if !value.Exists("fill") {
+		return errors.New("no fill")
+	}
+
+	type uBackgroundTypePattern BackgroundTypePattern
+	var ub uBackgroundTypePattern
+
+	fillType := string(value.GetStringBytes("fill", "type"))
+	switch fillType {
+	case BackgroundFilledSolid:
+		ub.Fill = &BackgroundFillSolid{}
+	case BackgroundFilledGradient:
+		ub.Fill = &BackgroundFillGradient{}
+	case BackgroundFilledFreeformGradient:
+		ub.Fill = &BackgroundFillFreeformGradient{}
+	default:
+		ub.Fill = &UnknownBackgroundFill{}
+	}
+
+	if err = json.Unmarshal(data, &ub); err != nil {
+		return err
+	}
+	*b = BackgroundTypePattern(ub)
+
+	return nil
+}
+
 // BackgroundTypeChatTheme - The background is taken directly from a built-in chat theme.
 type BackgroundTypeChatTheme struct {
 	// Type - Type of the background, always “chat_theme”
@@ -2097,7 +2252,7 @@ func (c *ChatBackground) UnmarshalJSON(data []byte) error {
 	case BackgroundTypeNameChatTheme:
 		uc.Type = &BackgroundTypeChatTheme{}
 	default:
-		return fmt.Errorf("unknown chat background type: %s", backgroundType)
+		uc.Type = &UnknownBackgroundType{}
 	}
 
 	if err = json.Unmarshal(data, &uc); err != nil {
@@ -3022,7 +3177,7 @@ func (c *ChatMemberUpdated) UnmarshalJSON(data []byte) error { //nolint:funlen,r
 	case MemberStatusBanned:
 		uc.OldChatMember = &ChatMemberBanned{}
 	default:
-		return fmt.Errorf("unknown chat member status: %s", oldMemberStatus)
+		uc.OldChatMember = &UnknownChatMember{}
 	}
 
 	newMemberStatus := string(value.GetStringBytes("new_chat_member", "status"))
@@ -3040,7 +3195,7 @@ func (c *ChatMemberUpdated) UnmarshalJSON(data []byte) error { //nolint:funlen,r
 	case MemberStatusBanned:
 		uc.NewChatMember = &ChatMemberBanned{}
 	default:
-		return fmt.Errorf("unknown chat member status: %s", newMemberStatus)
+		uc.NewChatMember = &UnknownChatMember{}
 	}
 
 	if err = json.Unmarshal(data, &uc); err != nil {
@@ -3092,7 +3247,9 @@ func (c *chatMemberData) UnmarshalJSON(data []byte) error {
 		err = json.Unmarshal(data, &cm)
 		c.Data = cm
 	default:
-		return fmt.Errorf("unknown member status: %q", memberStatus)
+		var cm *UnknownChatMember
+		err = json.Unmarshal(data, &cm)
+		c.Data = cm
 	}
 
 	return err
@@ -3649,7 +3806,7 @@ func (c *ReactionCount) UnmarshalJSON(data []byte) error {
 	case ReactionPaid:
 		uc.Type = &ReactionTypePaid{}
 	default:
-		return fmt.Errorf(unknownReactionTypeErr, reactionType)
+		uc.Type = &UnknownReactionType{}
 	}
 
 	if err = json.Unmarshal(data, &uc); err != nil {
@@ -3717,7 +3874,7 @@ func (u *MessageReactionUpdated) UnmarshalJSON(data []byte) error { //nolint:fun
 		case ReactionPaid:
 			uu.OldReaction[i] = &ReactionTypePaid{}
 		default:
-			return fmt.Errorf(unknownReactionTypeErr, reactionType)
+			uu.OldReaction[i] = &UnknownReactionType{}
 		}
 	}
 
@@ -3733,7 +3890,7 @@ func (u *MessageReactionUpdated) UnmarshalJSON(data []byte) error { //nolint:fun
 		case ReactionPaid:
 			uu.NewReaction[i] = &ReactionTypePaid{}
 		default:
-			return fmt.Errorf(unknownReactionTypeErr, reactionType)
+			uu.NewReaction[i] = &UnknownReactionType{}
 		}
 	}
 
@@ -4044,7 +4201,9 @@ func (m *menuButtonData) UnmarshalJSON(data []byte) error {
 		err = json.Unmarshal(data, &mb)
 		m.Data = mb
 	default:
-		return fmt.Errorf("unknown menu button type: %q", buttonType)
+		var mb *UnknownMenuButton
+		err = json.Unmarshal(data, &mb)
+		m.Data = mb
 	}
 
 	return err
@@ -4223,7 +4382,7 @@ func (b *ChatBoost) UnmarshalJSON(data []byte) error {
 	case BoostSourceGiveaway:
 		ub.Source = &ChatBoostSourceGiveaway{}
 	default:
-		return fmt.Errorf("unknown chat boost source: %s", source)
+		ub.Source = &UnknownChatBoostSource{}
 	}
 
 	if err = json.Unmarshal(data, &ub); err != nil {
@@ -4284,7 +4443,7 @@ func (b *ChatBoostRemoved) UnmarshalJSON(data []byte) error {
 	case BoostSourceGiveaway:
 		ub.Source = &ChatBoostSourceGiveaway{}
 	default:
-		return fmt.Errorf("unknown chat boost source: %s", source)
+		ub.Source = &UnknownChatBoostSource{}
 	}
 
 	if err = json.Unmarshal(data, &ub); err != nil {
@@ -4674,6 +4833,10 @@ type InputFile struct {
 	// URL - URL to get file from
 	URL string
 
+	// LocalPath - Absolute path to file on disk of local Bot API server, file is not read by the bot, but
+	// referenced using file URI (file:///path/to/file), works only with local Bot API server (see [WithLocalAPIServer])
+	LocalPath string
+
 	// needAttach used to specify that file field will be named the same as file name
 	needAttach bool
 }
@@ -4688,6 +4851,10 @@ func (i InputFile) String() string {
 		return i.URL
 	}
 
+	if i.LocalPath != "" {
+		return localFileURI(i.LocalPath)
+	}
+
 	if i.File != nil {
 		return i.File.Name()
 	}
@@ -4705,6 +4872,10 @@ func (i InputFile) MarshalJSON() ([]byte, error) {
 		return json.Marshal(i.URL)
 	}
 
+	if i.LocalPath != "" {
+		return json.Marshal(localFileURI(i.LocalPath))
+	}
+
 	if !isNil(i.File) {
 		if i.needAttach {
 			return json.Marshal(attachFile + i.File.Name())
@@ -4712,7 +4883,7 @@ func (i InputFile) MarshalJSON() ([]byte, error) {
 		return []byte(`""`), nil
 	}
 
-	return nil, errors.New("telego: file ID, URL and file are empty")
+	return nil, errors.New("telego: file ID, URL, local path and file are empty")
 }
 
 // InputPaidMedia - This object describes the paid media to be sent. Currently, it can be one of
@@ -6671,7 +6842,7 @@ func (p *TransactionPartnerUser) UnmarshalJSON(data []byte) error {
 			case PaidMediaTypeVideo:
 				up.PaidMedia[i] = &PaidMediaVideo{}
 			default:
-				return fmt.Errorf("unknown paid media type: %s", mediaType)
+				up.PaidMedia[i] = &UnknownPaidMedia{}
 			}
 		}
 	}
@@ -6721,6 +6892,41 @@ func (p *TransactionPartnerFragment) PartnerType() string {
 
 func (p *TransactionPartnerFragment) iTransactionPartner() {}
 
+// UnmarshalJSON converts JSON to TransactionPartnerFragment
+func (p *TransactionPartnerFragment) UnmarshalJSON(data []byte) error {
+	parser := json.ParserPoll.Get()
+	defer json.ParserPoll.Put(parser)
+
+	value, err := parser.ParseBytes(data)
+	if err != nil {
+		return err
+	}
+
+	type uTransactionPartnerFragment TransactionPartnerFragment
+	var up uTransactionPartnerFragment
+
+	if value.Exists("withdrawal_state") {
+		stateType := string(value.GetStringBytes("withdrawal_state", "type"))
+		switch stateType {
+		case WithdrawalStatePending:
+			up.WithdrawalState = &RevenueWithdrawalStatePending{}
+		case WithdrawalStateSucceeded:
+			up.WithdrawalState = &RevenueWithdrawalStateSucceeded{}
+		case WithdrawalStateFailed:
+			up.WithdrawalState = &RevenueWithdrawalStateFailed{}
+		default:
+			up.WithdrawalState = &UnknownRevenueWithdrawalState{}
+		}
+	}
+
+	if err = json.Unmarshal(data, &up); err != nil {
+		return err
+	}
+	*p = TransactionPartnerFragment(up)
+
+	return nil
+}
+
 // TransactionPartnerTelegramAds - Describes a withdrawal transaction to the Telegram Ads platform.
 type TransactionPartnerTelegramAds struct {
 	// Type - Type of the transaction partner, always “telegram_ads”
@@ -6821,7 +7027,7 @@ func (t *StarTransaction) UnmarshalJSON(data []byte) error { //nolint:gocyclo,re
 		case PartnerTypeOther:
 			ut.Source = &TransactionPartnerOther{}
 		default:
-			return fmt.Errorf("unknown source partner type: %s", partnerType)
+			ut.Source = &UnknownTransactionPartner{}
 		}
 	}
 
@@ -6841,7 +7047,7 @@ func (t *StarTransaction) UnmarshalJSON(data []byte) error { //nolint:gocyclo,re
 		case PartnerTypeOther:
 			ut.Receiver = &TransactionPartnerOther{}
 		default:
-			return fmt.Errorf("unknown receiver partner type: %s", partnerType)
+			ut.Receiver = &UnknownTransactionPartner{}
 		}
 	}
 
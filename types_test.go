@@ -3,6 +3,8 @@ package telego
 import (
 	"context"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -340,10 +342,13 @@ func Test_chatMemberData_UnmarshalJSON(t *testing.T) {
 			isError: false,
 		},
 		{
-			name:    "error_unknown_status",
-			json:    `{"status": "test status"}`,
-			data:    nil,
-			isError: true,
+			name: "success_unknown",
+			json: `{"status": "test status"}`,
+			data: &UnknownChatMember{
+				Status: "test status",
+				Raw:    []byte(`{"status": "test status"}`),
+			},
+			isError: false,
 		},
 		{
 			name:    "error_no_status",
@@ -452,10 +457,13 @@ func Test_menuButtonData_UnmarshalJSON(t *testing.T) {
 			isError: false,
 		},
 		{
-			name:    "error_unknown_type",
-			json:    `{"type": "test type"}`,
-			data:    nil,
-			isError: true,
+			name: "success_unknown",
+			json: `{"type": "test type"}`,
+			data: &UnknownMenuButton{
+				Type: "test type",
+				Raw:  []byte(`{"type": "test type"}`),
+			},
+			isError: false,
 		},
 		{
 			name:    "error_no_type",
@@ -615,6 +623,14 @@ func TestInputFile_MarshalJSON(t *testing.T) {
 			jsonData: `"url"`,
 			isError:  false,
 		},
+		{
+			name: "success_local_path",
+			inputFile: InputFile{
+				LocalPath: "/path/to/file",
+			},
+			jsonData: `"file:///path/to/file"`,
+			isError:  false,
+		},
 		{
 			name:      "error",
 			inputFile: InputFile{},
@@ -833,7 +849,7 @@ func TestUpdate_Clone(t *testing.T) {
 	})
 
 	assert.Panics(t, func() {
-		_ = (Update{ChatMember: &ChatMemberUpdated{}}).Clone()
+		_ = (Update{MyChatMember: &ChatMemberUpdated{OldChatMember: badChatMember{}}}).Clone()
 	})
 }
 
@@ -921,10 +937,15 @@ func TestUpdate_CloneSafe(t *testing.T) {
 		assert.Equal(t, u, uc)
 	})
 
-	t.Run("error_unmarshal", func(t *testing.T) {
-		uc, err := (Update{ChatMember: &ChatMemberUpdated{}}).CloneSafe()
-		require.Error(t, err)
-		assert.Zero(t, uc)
+	t.Run("success_unknown_variant", func(t *testing.T) {
+		uc, err := (Update{ChatMember: &ChatMemberUpdated{
+			OldChatMember: &UnknownChatMember{Status: "test"},
+			NewChatMember: &ChatMemberMember{Status: MemberStatusMember},
+		}}).CloneSafe()
+		require.NoError(t, err)
+		require.NotNil(t, uc.ChatMember)
+		require.IsType(t, &UnknownChatMember{}, uc.ChatMember.OldChatMember)
+		assert.Equal(t, "test", uc.ChatMember.OldChatMember.MemberStatus())
 	})
 
 	t.Run("error_marshal", func(t *testing.T) {
@@ -1024,6 +1045,13 @@ func TestInputFile_String(t *testing.T) {
 			},
 			stringValue: "url",
 		},
+		{
+			name: "local_path",
+			inputFile: InputFile{
+				LocalPath: `C:\file`,
+			},
+			stringValue: "file:///C:/file",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1049,6 +1077,26 @@ func TestUpdate_Context(t *testing.T) {
 	})
 }
 
+func TestUpdate_Type(t *testing.T) {
+	assert.Equal(t, "", Update{UpdateID: 1}.Type())
+
+	updateType := reflect.TypeOf(Update{})
+	for i := 0; i < updateType.NumField(); i++ {
+		field := updateType.Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Pointer {
+			continue
+		}
+
+		t.Run(field.Name, func(t *testing.T) {
+			update := Update{}
+			reflect.ValueOf(&update).Elem().Field(i).Set(reflect.New(field.Type.Elem()))
+
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			assert.Equal(t, name, update.Type())
+		})
+	}
+}
+
 func Test_ChatFullInfo_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1071,10 +1119,17 @@ func Test_ChatFullInfo_UnmarshalJSON(t *testing.T) {
 			isError: true,
 		},
 		{
-			name:    "error_invalid_available_reactions",
-			json:    `{"available_reactions": [{}]}`,
-			data:    nil,
-			isError: true,
+			name: "success_unknown_available_reactions",
+			json: `{"available_reactions": [{"type": "test"}]}`,
+			data: &ChatFullInfo{
+				AvailableReactions: []ReactionType{
+					&UnknownReactionType{
+						Type: "test",
+						Raw:  []byte(`{"type": "test"}`),
+					},
+				},
+			},
+			isError: false,
 		},
 	}
 	for _, tt := range tests {
@@ -1122,10 +1177,16 @@ func Test_ExternalReplyInfo_UnmarshalJSON(t *testing.T) {
 			isError: true,
 		},
 		{
-			name:    "error_invalid_origin",
-			json:    `{"origin": {}}`,
-			data:    nil,
-			isError: true,
+			name: "success_unknown_origin",
+			json: `{"origin": {"type": "test", "date": 1}}`,
+			data: &ExternalReplyInfo{
+				Origin: &UnknownMessageOrigin{
+					Type: "test",
+					Date: 1,
+					Raw:  []byte(`{"type": "test", "date": 1}`),
+				},
+			},
+			isError: false,
 		},
 	}
 	for _, tt := range tests {
@@ -1215,10 +1276,14 @@ func Test_ReactionCount_UnmarshalJSON(t *testing.T) {
 			isError: true,
 		},
 		{
-			name:    "error_invalid_type",
-			json:    `{"type": {}}`,
-			data:    nil,
-			isError: true,
+			name: "success_unknown_type",
+			json: `{"type": {}}`,
+			data: &ReactionCount{
+				Type: &UnknownReactionType{
+					Raw: []byte(`{}`),
+				},
+			},
+			isError: false,
 		},
 	}
 	for _, tt := range tests {
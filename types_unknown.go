@@ -0,0 +1,485 @@
+package telego
+
+import (
+	"github.com/mymmrac/telego/internal/json"
+)
+
+// unknownVariant represents a variant of union type that is not known to the current version of Telego, it is
+// used as a fallback to not fail decoding of the whole object when Telegram introduces new variants
+type unknownVariant interface {
+	unknownVariantType() string
+}
+
+// rawCopy returns a copy of raw JSON data, since data passed to UnmarshalJSON can't be retained
+func rawCopy(data []byte) json.RawMessage {
+	if data == nil {
+		return nil
+	}
+
+	raw := make(json.RawMessage, len(data))
+	copy(raw, data)
+	return raw
+}
+
+// UnknownMessageOrigin - Represents [MessageOrigin] of type unknown to Telego, the original JSON is kept in Raw
+type UnknownMessageOrigin struct {
+	// Type - Type of the message origin
+	Type string `json:"type"`
+
+	// Date - Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+
+	// Raw - Raw JSON of the message origin as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// OriginType returns original message type
+func (m *UnknownMessageOrigin) OriginType() string {
+	return m.Type
+}
+
+// OriginalDate returns original message date
+func (m *UnknownMessageOrigin) OriginalDate() int64 {
+	return m.Date
+}
+
+func (m *UnknownMessageOrigin) iMessageOrigin() {}
+
+func (m *UnknownMessageOrigin) unknownVariantType() string {
+	return m.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownMessageOrigin
+func (m *UnknownMessageOrigin) UnmarshalJSON(data []byte) error {
+	type uUnknownMessageOrigin UnknownMessageOrigin
+	var um uUnknownMessageOrigin
+
+	if err := json.Unmarshal(data, &um); err != nil {
+		return err
+	}
+	um.Raw = rawCopy(data)
+	*m = UnknownMessageOrigin(um)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownMessageOrigin to JSON, raw JSON is used if present
+func (m UnknownMessageOrigin) MarshalJSON() ([]byte, error) {
+	if len(m.Raw) != 0 {
+		return m.Raw, nil
+	}
+
+	type uUnknownMessageOrigin UnknownMessageOrigin
+	return json.Marshal(uUnknownMessageOrigin(m))
+}
+
+// UnknownPaidMedia - Represents [PaidMedia] of type unknown to Telego, the original JSON is kept in Raw
+type UnknownPaidMedia struct {
+	// Type - Type of the paid media
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the paid media as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// MediaType returns PaidMedia type
+func (p *UnknownPaidMedia) MediaType() string {
+	return p.Type
+}
+
+func (p *UnknownPaidMedia) iPaidMedia() {}
+
+func (p *UnknownPaidMedia) unknownVariantType() string {
+	return p.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownPaidMedia
+func (p *UnknownPaidMedia) UnmarshalJSON(data []byte) error {
+	type uUnknownPaidMedia UnknownPaidMedia
+	var up uUnknownPaidMedia
+
+	if err := json.Unmarshal(data, &up); err != nil {
+		return err
+	}
+	up.Raw = rawCopy(data)
+	*p = UnknownPaidMedia(up)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownPaidMedia to JSON, raw JSON is used if present
+func (p UnknownPaidMedia) MarshalJSON() ([]byte, error) {
+	if len(p.Raw) != 0 {
+		return p.Raw, nil
+	}
+
+	type uUnknownPaidMedia UnknownPaidMedia
+	return json.Marshal(uUnknownPaidMedia(p))
+}
+
+// UnknownBackgroundFill - Represents [BackgroundFill] of type unknown to Telego, the original JSON is kept in Raw
+type UnknownBackgroundFill struct {
+	// Type - Type of the background fill
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the background fill as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// BackgroundFilled returns BackgroundFill type
+func (b *UnknownBackgroundFill) BackgroundFilled() string {
+	return b.Type
+}
+
+func (b *UnknownBackgroundFill) iBackgroundFill() {}
+
+func (b *UnknownBackgroundFill) unknownVariantType() string {
+	return b.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownBackgroundFill
+func (b *UnknownBackgroundFill) UnmarshalJSON(data []byte) error {
+	type uUnknownBackgroundFill UnknownBackgroundFill
+	var ub uUnknownBackgroundFill
+
+	if err := json.Unmarshal(data, &ub); err != nil {
+		return err
+	}
+	ub.Raw = rawCopy(data)
+	*b = UnknownBackgroundFill(ub)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownBackgroundFill to JSON, raw JSON is used if present
+func (b UnknownBackgroundFill) MarshalJSON() ([]byte, error) {
+	if len(b.Raw) != 0 {
+		return b.Raw, nil
+	}
+
+	type uUnknownBackgroundFill UnknownBackgroundFill
+	return json.Marshal(uUnknownBackgroundFill(b))
+}
+
+// UnknownBackgroundType - Represents [BackgroundType] of type unknown to Telego, the original JSON is kept in Raw
+type UnknownBackgroundType struct {
+	// Type - Type of the background
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the background type as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// BackgroundType returns BackgroundType type
+func (b *UnknownBackgroundType) BackgroundType() string {
+	return b.Type
+}
+
+func (b *UnknownBackgroundType) iBackgroundType() {}
+
+func (b *UnknownBackgroundType) unknownVariantType() string {
+	return b.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownBackgroundType
+func (b *UnknownBackgroundType) UnmarshalJSON(data []byte) error {
+	type uUnknownBackgroundType UnknownBackgroundType
+	var ub uUnknownBackgroundType
+
+	if err := json.Unmarshal(data, &ub); err != nil {
+		return err
+	}
+	ub.Raw = rawCopy(data)
+	*b = UnknownBackgroundType(ub)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownBackgroundType to JSON, raw JSON is used if present
+func (b UnknownBackgroundType) MarshalJSON() ([]byte, error) {
+	if len(b.Raw) != 0 {
+		return b.Raw, nil
+	}
+
+	type uUnknownBackgroundType UnknownBackgroundType
+	return json.Marshal(uUnknownBackgroundType(b))
+}
+
+// UnknownChatMember - Represents [ChatMember] with status unknown to Telego, the original JSON is kept in Raw
+type UnknownChatMember struct {
+	// Status - The member's status in the chat
+	Status string `json:"status"`
+
+	// User - Information about the user
+	User User `json:"user"`
+
+	// Raw - Raw JSON of the chat member as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// MemberStatus returns ChatMember status
+func (c *UnknownChatMember) MemberStatus() string {
+	return c.Status
+}
+
+// MemberUser returns ChatMember User
+func (c *UnknownChatMember) MemberUser() User {
+	return c.User
+}
+
+// MemberIsMember returns true if ChatMember is member of chat, always false as status is unknown
+func (c *UnknownChatMember) MemberIsMember() bool {
+	return false
+}
+
+func (c *UnknownChatMember) iChatMember() {}
+
+func (c *UnknownChatMember) unknownVariantType() string {
+	return c.Status
+}
+
+// UnmarshalJSON converts JSON to UnknownChatMember
+func (c *UnknownChatMember) UnmarshalJSON(data []byte) error {
+	type uUnknownChatMember UnknownChatMember
+	var uc uUnknownChatMember
+
+	if err := json.Unmarshal(data, &uc); err != nil {
+		return err
+	}
+	uc.Raw = rawCopy(data)
+	*c = UnknownChatMember(uc)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownChatMember to JSON, raw JSON is used if present
+func (c UnknownChatMember) MarshalJSON() ([]byte, error) {
+	if len(c.Raw) != 0 {
+		return c.Raw, nil
+	}
+
+	type uUnknownChatMember UnknownChatMember
+	return json.Marshal(uUnknownChatMember(c))
+}
+
+// UnknownReactionType - Represents [ReactionType] of type unknown to Telego, the original JSON is kept in Raw
+type UnknownReactionType struct {
+	// Type - Type of the reaction
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the reaction type as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// ReactionType returns reaction type
+func (r *UnknownReactionType) ReactionType() string {
+	return r.Type
+}
+
+func (r *UnknownReactionType) iReactionType() {}
+
+func (r *UnknownReactionType) unknownVariantType() string {
+	return r.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownReactionType
+func (r *UnknownReactionType) UnmarshalJSON(data []byte) error {
+	type uUnknownReactionType UnknownReactionType
+	var ur uUnknownReactionType
+
+	if err := json.Unmarshal(data, &ur); err != nil {
+		return err
+	}
+	ur.Raw = rawCopy(data)
+	*r = UnknownReactionType(ur)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownReactionType to JSON, raw JSON is used if present
+func (r UnknownReactionType) MarshalJSON() ([]byte, error) {
+	if len(r.Raw) != 0 {
+		return r.Raw, nil
+	}
+
+	type uUnknownReactionType UnknownReactionType
+	return json.Marshal(uUnknownReactionType(r))
+}
+
+// UnknownMenuButton - Represents [MenuButton] of type unknown to Telego, the original JSON is kept in Raw
+type UnknownMenuButton struct {
+	// Type - Type of the button
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the menu button as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// ButtonType returns MenuButton type
+func (m *UnknownMenuButton) ButtonType() string {
+	return m.Type
+}
+
+func (m *UnknownMenuButton) iMenuButton() {}
+
+func (m *UnknownMenuButton) unknownVariantType() string {
+	return m.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownMenuButton
+func (m *UnknownMenuButton) UnmarshalJSON(data []byte) error {
+	type uUnknownMenuButton UnknownMenuButton
+	var um uUnknownMenuButton
+
+	if err := json.Unmarshal(data, &um); err != nil {
+		return err
+	}
+	um.Raw = rawCopy(data)
+	*m = UnknownMenuButton(um)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownMenuButton to JSON, raw JSON is used if present
+func (m UnknownMenuButton) MarshalJSON() ([]byte, error) {
+	if len(m.Raw) != 0 {
+		return m.Raw, nil
+	}
+
+	type uUnknownMenuButton UnknownMenuButton
+	return json.Marshal(uUnknownMenuButton(m))
+}
+
+// UnknownChatBoostSource - Represents [ChatBoostSource] of source unknown to Telego, the original JSON is kept in Raw
+type UnknownChatBoostSource struct {
+	// Source - Source of the boost
+	Source string `json:"source"`
+
+	// Raw - Raw JSON of the boost source as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// BoostSource returns boost source
+func (b *UnknownChatBoostSource) BoostSource() string {
+	return b.Source
+}
+
+func (b *UnknownChatBoostSource) iChatBoostSource() {}
+
+func (b *UnknownChatBoostSource) unknownVariantType() string {
+	return b.Source
+}
+
+// UnmarshalJSON converts JSON to UnknownChatBoostSource
+func (b *UnknownChatBoostSource) UnmarshalJSON(data []byte) error {
+	type uUnknownChatBoostSource UnknownChatBoostSource
+	var ub uUnknownChatBoostSource
+
+	if err := json.Unmarshal(data, &ub); err != nil {
+		return err
+	}
+	ub.Raw = rawCopy(data)
+	*b = UnknownChatBoostSource(ub)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownChatBoostSource to JSON, raw JSON is used if present
+func (b UnknownChatBoostSource) MarshalJSON() ([]byte, error) {
+	if len(b.Raw) != 0 {
+		return b.Raw, nil
+	}
+
+	type uUnknownChatBoostSource UnknownChatBoostSource
+	return json.Marshal(uUnknownChatBoostSource(b))
+}
+
+// UnknownTransactionPartner - Represents [TransactionPartner] of type unknown to Telego, the original JSON is kept
+// in Raw
+type UnknownTransactionPartner struct {
+	// Type - Type of the transaction partner
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the transaction partner as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// PartnerType returns TransactionPartner type
+func (p *UnknownTransactionPartner) PartnerType() string {
+	return p.Type
+}
+
+func (p *UnknownTransactionPartner) iTransactionPartner() {}
+
+func (p *UnknownTransactionPartner) unknownVariantType() string {
+	return p.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownTransactionPartner
+func (p *UnknownTransactionPartner) UnmarshalJSON(data []byte) error {
+	type uUnknownTransactionPartner UnknownTransactionPartner
+	var up uUnknownTransactionPartner
+
+	if err := json.Unmarshal(data, &up); err != nil {
+		return err
+	}
+	up.Raw = rawCopy(data)
+	*p = UnknownTransactionPartner(up)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownTransactionPartner to JSON, raw JSON is used if present
+func (p UnknownTransactionPartner) MarshalJSON() ([]byte, error) {
+	if len(p.Raw) != 0 {
+		return p.Raw, nil
+	}
+
+	type uUnknownTransactionPartner UnknownTransactionPartner
+	return json.Marshal(uUnknownTransactionPartner(p))
+}
+
+// UnknownRevenueWithdrawalState - Represents [RevenueWithdrawalState] of type unknown to Telego, the original JSON
+// is kept in Raw
+type UnknownRevenueWithdrawalState struct {
+	// Type - Type of the state
+	Type string `json:"type"`
+
+	// Raw - Raw JSON of the withdrawal state as received from Telegram
+	Raw json.RawMessage `json:"-"`
+}
+
+// WithdrawalState returns RevenueWithdrawalState type
+func (r *UnknownRevenueWithdrawalState) WithdrawalState() string {
+	return r.Type
+}
+
+func (r *UnknownRevenueWithdrawalState) iRevenueWithdrawalState() {}
+
+func (r *UnknownRevenueWithdrawalState) unknownVariantType() string {
+	return r.Type
+}
+
+// UnmarshalJSON converts JSON to UnknownRevenueWithdrawalState
+func (r *UnknownRevenueWithdrawalState) UnmarshalJSON(data []byte) error {
+	type uUnknownRevenueWithdrawalState UnknownRevenueWithdrawalState
+	var ur uUnknownRevenueWithdrawalState
+
+	if err := json.Unmarshal(data, &ur); err != nil {
+		return err
+	}
+	ur.Raw = rawCopy(data)
+	*r = UnknownRevenueWithdrawalState(ur)
+
+	return nil
+}
+
+// MarshalJSON converts UnknownRevenueWithdrawalState to JSON, raw JSON is used if present
+func (r UnknownRevenueWithdrawalState) MarshalJSON() ([]byte, error) {
+	if len(r.Raw) != 0 {
+		return r.Raw, nil
+	}
+
+	type uUnknownRevenueWithdrawalState UnknownRevenueWithdrawalState
+	return json.Marshal(uUnknownRevenueWithdrawalState(r))
+}
@@ -0,0 +1,104 @@
+package telego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mymmrac/telego/internal/json"
+)
+
+func TestUnknownTypesInterfaces(t *testing.T) {
+	assert.Implements(t, (*MessageOrigin)(nil), &UnknownMessageOrigin{})
+	assert.Implements(t, (*PaidMedia)(nil), &UnknownPaidMedia{})
+	assert.Implements(t, (*BackgroundFill)(nil), &UnknownBackgroundFill{})
+	assert.Implements(t, (*BackgroundType)(nil), &UnknownBackgroundType{})
+	assert.Implements(t, (*ChatMember)(nil), &UnknownChatMember{})
+	assert.Implements(t, (*ReactionType)(nil), &UnknownReactionType{})
+	assert.Implements(t, (*MenuButton)(nil), &UnknownMenuButton{})
+	assert.Implements(t, (*ChatBoostSource)(nil), &UnknownChatBoostSource{})
+	assert.Implements(t, (*TransactionPartner)(nil), &UnknownTransactionPartner{})
+	assert.Implements(t, (*RevenueWithdrawalState)(nil), &UnknownRevenueWithdrawalState{})
+
+	m := &UnknownChatMember{Status: "test", User: User{ID: 1}}
+	assert.Equal(t, "test", m.MemberStatus())
+	assert.Equal(t, User{ID: 1}, m.MemberUser())
+	assert.False(t, m.MemberIsMember())
+}
+
+func TestUnknownTypes_JSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		data interface {
+			MarshalJSON() ([]byte, error)
+			UnmarshalJSON(data []byte) error
+		}
+	}{
+		{name: "message_origin", json: `{"type":"test","date":1,"new":true}`, data: &UnknownMessageOrigin{}},
+		{name: "paid_media", json: `{"type":"test","new":true}`, data: &UnknownPaidMedia{}},
+		{name: "background_fill", json: `{"type":"test","new":true}`, data: &UnknownBackgroundFill{}},
+		{name: "background_type", json: `{"type":"test","new":true}`, data: &UnknownBackgroundType{}},
+		{name: "chat_member", json: `{"status":"test","user":{"id":1},"new":true}`, data: &UnknownChatMember{}},
+		{name: "reaction_type", json: `{"type":"test","new":true}`, data: &UnknownReactionType{}},
+		{name: "menu_button", json: `{"type":"test","new":true}`, data: &UnknownMenuButton{}},
+		{name: "chat_boost_source", json: `{"source":"test","new":true}`, data: &UnknownChatBoostSource{}},
+		{name: "transaction_partner", json: `{"type":"test","new":true}`, data: &UnknownTransactionPartner{}},
+		{name: "revenue_withdrawal_state", json: `{"type":"test","new":true}`, data: &UnknownRevenueWithdrawalState{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.UnmarshalJSON([]byte(tt.json))
+			require.NoError(t, err)
+
+			variant, ok := tt.data.(unknownVariant)
+			require.True(t, ok)
+			assert.Equal(t, "test", variant.unknownVariantType())
+
+			data, err := tt.data.MarshalJSON()
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(data))
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		err := (&UnknownMessageOrigin{}).UnmarshalJSON([]byte("invalid"))
+		require.Error(t, err)
+	})
+
+	t.Run("marshal_without_raw", func(t *testing.T) {
+		data, err := UnknownMessageOrigin{Type: "test", Date: 1}.MarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"test","date":1}`, string(data))
+	})
+}
+
+func TestUpdate_Raw(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, Update{}.Raw())
+	})
+
+	t.Run("unknown_variant", func(t *testing.T) {
+		data := `{"update_id":1,"message":{"message_id":2,"date":0,"chat":{"id":3,"type":"private"},` +
+			`"forward_origin":{"type":"test","date":4}}}`
+
+		var update Update
+		err := json.Unmarshal([]byte(data), &update)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, data, string(update.Raw()))
+		require.NotNil(t, update.Message)
+		assert.Equal(t, &UnknownMessageOrigin{
+			Type: "test",
+			Date: 4,
+			Raw:  []byte(`{"type":"test","date":4}`),
+		}, update.Message.ForwardOrigin)
+
+		clone, err := update.CloneSafe()
+		require.NoError(t, err)
+		assert.Equal(t, update.Raw(), clone.Raw())
+		assert.Equal(t, update.Message.ForwardOrigin, clone.Message.ForwardOrigin)
+	})
+}
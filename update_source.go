@@ -0,0 +1,232 @@
+package telego
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// UpdateSource represents source of updates with unified lifecycle, implemented by [LongPollingSource],
+// [WebhookSource] and [FailoverSource], custom sources (for example, message queue consumers) can implement it too
+type UpdateSource interface {
+	// Start starts getting updates, once source is stopped update chan is closed.
+	// Source is stopped when provided context is done.
+	Start(ctx context.Context) (<-chan Update, error)
+
+	// Stop stops getting updates, calling it multiple times does nothing
+	Stop(ctx context.Context) error
+
+	// Err returns error that stopped source, nil if source is running or was stopped using Stop method
+	Err() error
+}
+
+// LongPollingSource represents [UpdateSource] that gets updates using [Bot.UpdatesViaLongPolling] method
+type LongPollingSource struct {
+	bot     *Bot
+	params  *GetUpdatesParams
+	options []LongPollingOption
+
+	lock      sync.Mutex
+	ctx       *longPollingContext
+	stopAfter func() bool
+}
+
+// NewLongPollingSource creates new long polling source, params and options are passed to
+// [Bot.UpdatesViaLongPolling] method on each start, context passed to start is used as long polling context
+// (see [WithLongPollingContext])
+func NewLongPollingSource(bot *Bot, params *GetUpdatesParams, options ...LongPollingOption) *LongPollingSource {
+	return &LongPollingSource{
+		bot:     bot,
+		params:  params,
+		options: options,
+	}
+}
+
+// Start starts long polling
+func (s *LongPollingSource) Start(ctx context.Context) (<-chan Update, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var params *GetUpdatesParams
+	if s.params != nil {
+		paramsCopy := *s.params
+		params = &paramsCopy
+	}
+
+	options := append([]LongPollingOption{WithLongPollingContext(ctx)}, s.options...)
+	updates, err := s.bot.UpdatesViaLongPolling(params, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.ctx = s.bot.longPollingContext
+	lpCtx := s.ctx
+	s.stopAfter = context.AfterFunc(ctx, func() {
+		s.bot.stopLongPolling(lpCtx, nil)
+	})
+
+	return updates, nil
+}
+
+// Stop stops long polling
+func (s *LongPollingSource) Stop(_ context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ctx == nil {
+		return nil
+	}
+
+	s.stopAfter()
+	s.bot.stopLongPolling(s.ctx, nil)
+	if s.bot.longPollingContext == s.ctx {
+		s.bot.longPollingContext = nil
+	}
+	s.ctx = nil
+
+	return nil
+}
+
+// Err returns fatal error that stopped long polling (see [WithLongPollingFatalErrors])
+func (s *LongPollingSource) Err() error {
+	s.lock.Lock()
+	lpCtx := s.ctx
+	s.lock.Unlock()
+
+	if lpCtx == nil {
+		return nil
+	}
+
+	lpCtx.runningLock.RLock()
+	defer lpCtx.runningLock.RUnlock()
+
+	return lpCtx.err
+}
+
+// usesOffsetStore reports whether long polling uses offset store (see [WithLongPollingOffsetStore])
+func (s *LongPollingSource) usesOffsetStore() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.ctx != nil && s.ctx.offsetStore != nil
+}
+
+// WebhookSource represents [UpdateSource] that gets updates using [Bot.UpdatesViaWebhook] method and serves them
+// using [Bot.StartWebhook] method
+type WebhookSource struct {
+	bot     *Bot
+	path    string
+	address string
+	params  *SetWebhookParams
+	options []WebhookOption
+
+	lock      sync.Mutex
+	ctx       *webhookContext
+	stopAfter func() bool
+	err       error
+}
+
+// NewWebhookSource creates new webhook source, path and options are passed to [Bot.UpdatesViaWebhook] method and
+// address to [Bot.StartWebhook] method on each start. If params are not nil, [Bot.SetWebhook] method is called with
+// them on start, unless webhook uses self-signed certificate (see [WithWebhookSelfSignedTLS]).
+func NewWebhookSource(bot *Bot, path, address string, params *SetWebhookParams, options ...WebhookOption,
+) *WebhookSource {
+	return &WebhookSource{
+		bot:     bot,
+		path:    path,
+		address: address,
+		params:  params,
+		options: options,
+	}
+}
+
+// Start starts webhook server in background and sets webhook if params are provided
+func (s *WebhookSource) Start(ctx context.Context) (<-chan Update, error) {
+	return s.start(ctx)
+}
+
+// start starts webhook source, additional options are applied after options of source
+func (s *WebhookSource) start(ctx context.Context, options ...WebhookOption) (<-chan Update, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	updates, err := s.bot.UpdatesViaWebhook(s.path, append(slices.Clone(s.options), options...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookCtx := s.bot.webhookContext
+	if s.params != nil && webhookCtx.tls == nil {
+		if err = s.bot.SetWebhook(ctx, s.params); err != nil {
+			s.closeWebhook(webhookCtx)
+			return nil, fmt.Errorf("telego: webhook source: %w", err)
+		}
+	}
+
+	if err = webhookCtx.markRunning(); err != nil {
+		s.closeWebhook(webhookCtx)
+		return nil, fmt.Errorf("telego: webhook source: %w", err)
+	}
+
+	s.ctx = webhookCtx
+	s.err = nil
+
+	go func() {
+		if serveErr := s.bot.serveWebhook(webhookCtx, s.address); serveErr != nil {
+			s.lock.Lock()
+			if s.ctx == webhookCtx {
+				s.err = serveErr
+			}
+			s.lock.Unlock()
+		}
+	}()
+
+	s.stopAfter = context.AfterFunc(ctx, func() {
+		_ = s.Stop(context.WithoutCancel(ctx))
+	})
+
+	return updates, nil
+}
+
+// Stop stops webhook server, webhook itself is not deleted
+func (s *WebhookSource) Stop(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ctx == nil {
+		return nil
+	}
+
+	s.stopAfter()
+	webhookCtx := s.ctx
+	s.ctx = nil
+
+	return s.bot.stopWebhook(ctx, webhookCtx)
+}
+
+// webhookContext returns context of started webhook, nil if source isn't started
+func (s *WebhookSource) webhookContext() *webhookContext {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.ctx
+}
+
+// closeWebhook closes webhook that was configured, but not started
+func (s *WebhookSource) closeWebhook(webhookCtx *webhookContext) {
+	webhookCtx.runningLock.Lock()
+	close(webhookCtx.stop)
+	webhookCtx.configured = false
+	webhookCtx.runningLock.Unlock()
+
+	s.bot.webhookContext = nil
+}
+
+// Err returns error returned by webhook server
+func (s *WebhookSource) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.err
+}
@@ -0,0 +1,412 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultFailoverCheckInterval     = time.Minute
+	defaultFailoverFailureThreshold  = 3
+	defaultFailoverRecoveryThreshold = 3
+	failoverCallTimeout              = time.Second * 30
+)
+
+// FailoverConfig represents configuration of [FailoverSource]
+type FailoverConfig struct {
+	// CheckInterval - Interval between checks of webhook endpoint. Default is 1 minute.
+	CheckInterval time.Duration
+
+	// FailureThreshold - Number of consecutive failed checks to fall back to long polling. Default is 3.
+	FailureThreshold int
+
+	// RecoveryThreshold - Number of consecutive successful checks to switch back to webhook. Default is 3.
+	RecoveryThreshold int
+
+	// Probe - Checks whether webhook endpoint is reachable. Default sends GET request to webhook URL and treats any
+	// response as reachable, so custom probe should be used if webhook URL uses self-signed certificate.
+	Probe func(ctx context.Context) error
+
+	// OnSwitch - Optional callback called after switching to webhook or long polling source, with reason of
+	// switching to long polling
+	OnSwitch func(active UpdateSource, reason error)
+}
+
+// FailoverSource represents [UpdateSource] that gets updates via webhook and falls back to long polling (calling
+// [Bot.DeleteWebhook] method) when webhook endpoint becomes unreachable, then switches back to webhook (calling
+// [Bot.SetWebhook] method) once it recovers.
+//
+// Webhook endpoint is considered unreachable if probe fails (see [FailoverConfig]) or Telegram reports a webhook
+// delivery error since the last check while there are pending updates. Webhook server keeps running while long polling
+// is used, so it can be probed.
+//
+// Note: [WithWebhookWatchdog] can't be used with failover, as it sets webhook again once it's deleted
+type FailoverSource struct {
+	webhook     *WebhookSource
+	longPolling *LongPollingSource
+	config      FailoverConfig
+
+	lock    sync.Mutex
+	running bool
+	active  UpdateSource
+	stop    chan struct{}
+	done    chan struct{}
+	err     error
+}
+
+// NewFailoverSource creates new failover source, webhook source should have set webhook params (see
+// [NewWebhookSource]) and both sources should use the same bot
+func NewFailoverSource(webhook *WebhookSource, longPolling *LongPollingSource, config FailoverConfig,
+) (*FailoverSource, error) {
+	if webhook == nil || longPolling == nil {
+		return nil, errors.New("telego: failover: webhook and long polling sources are required")
+	}
+	if webhook.params == nil {
+		return nil, errors.New("telego: failover: webhook source params are required")
+	}
+	if webhook.bot != longPolling.bot {
+		return nil, errors.New("telego: failover: webhook and long polling sources should use the same bot")
+	}
+
+	if config.CheckInterval == 0 {
+		config.CheckInterval = defaultFailoverCheckInterval
+	}
+	if config.FailureThreshold == 0 {
+		config.FailureThreshold = defaultFailoverFailureThreshold
+	}
+	if config.RecoveryThreshold == 0 {
+		config.RecoveryThreshold = defaultFailoverRecoveryThreshold
+	}
+	if config.CheckInterval < 0 || config.FailureThreshold < 0 || config.RecoveryThreshold < 0 {
+		return nil, errors.New("telego: failover: check interval and thresholds should not be negative")
+	}
+	if config.Probe == nil {
+		config.Probe = probeWebhookURL(webhook.params.URL)
+	}
+
+	return &FailoverSource{
+		webhook:     webhook,
+		longPolling: longPolling,
+		config:      config,
+	}, nil
+}
+
+// probeWebhookURL returns probe that sends GET request to webhook URL
+func probeWebhookURL(url string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return fmt.Errorf("send request: %w", err)
+		}
+		_ = response.Body.Close()
+
+		return nil
+	}
+}
+
+// Start starts webhook source and monitoring of webhook endpoint
+func (f *FailoverSource) Start(ctx context.Context) (<-chan Update, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.running {
+		return nil, errors.New("telego: failover: already running")
+	}
+
+	webhookUpdates, err := f.webhook.start(ctx, withoutWebhookWatchdog())
+	if err != nil {
+		return nil, fmt.Errorf("telego: failover: %w", err)
+	}
+
+	f.running = true
+	f.stop = make(chan struct{})
+	f.done = make(chan struct{})
+	f.active = f.webhook
+	f.err = nil
+
+	updates := make(chan Update)
+	go f.run(ctx, f.stop, f.done, webhookUpdates, updates)
+
+	return updates, nil
+}
+
+// withoutWebhookWatchdog returns option that fails if webhook watchdog is set by previous options, so webhook is not
+// set before watchdog is rejected
+func withoutWebhookWatchdog() WebhookOption {
+	return func(_ *Bot, ctx *webhookContext) error {
+		if ctx.watchdog != nil {
+			return errors.New("webhook watchdog can't be used with failover")
+		}
+		return nil
+	}
+}
+
+// Stop stops both sources and waits until update chan is closed
+func (f *FailoverSource) Stop(_ context.Context) error {
+	f.lock.Lock()
+	stop, done := f.stop, f.done
+	if stop != nil {
+		close(stop)
+		f.stop = nil
+	}
+	f.lock.Unlock()
+
+	if done != nil {
+		<-done
+	}
+	return nil
+}
+
+// Err returns error that stopped webhook or long polling source
+func (f *FailoverSource) Err() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.err
+}
+
+// Active returns currently used source, webhook or long polling
+func (f *FailoverSource) Active() UpdateSource {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.active
+}
+
+// failoverState represents state of running failover source
+type failoverState struct {
+	updates chan<- Update
+	quit    chan struct{}
+
+	webhookDone     <-chan struct{}
+	longPollingDone <-chan struct{}
+	lastUpdateID    atomic.Int64
+
+	failures  int
+	successes int
+	lastCheck time.Time
+}
+
+// run forwards updates of active source and switches sources until failover is stopped
+func (f *FailoverSource) run(ctx context.Context, stop <-chan struct{}, done chan<- struct{},
+	webhookUpdates <-chan Update, updates chan<- Update,
+) {
+	defer close(done)
+	defer close(updates)
+
+	state := &failoverState{
+		updates:   updates,
+		quit:      make(chan struct{}),
+		lastCheck: time.Now(),
+	}
+
+	state.webhookDone = state.forward(webhookUpdates, nil)
+
+	ticker := time.NewTicker(f.config.CheckInterval)
+	defer ticker.Stop()
+
+	var err error
+loop:
+	for {
+		select {
+		case <-stop:
+			break loop
+		case <-ctx.Done():
+			break loop
+		case <-state.webhookDone:
+			// Webhook source is stopped on its own once context is done, that's not a failure
+			if ctx.Err() == nil {
+				err = f.webhook.Err()
+				if err == nil {
+					err = errors.New("telego: failover: webhook stopped")
+				}
+			}
+			break loop
+		case <-state.longPollingDone:
+			if ctx.Err() == nil {
+				err = f.longPolling.Err()
+				if err == nil {
+					err = errors.New("telego: failover: long polling stopped")
+				}
+			}
+			break loop
+		case <-ticker.C:
+			if err = f.check(ctx, state); err != nil {
+				break loop
+			}
+		}
+	}
+
+	close(state.quit)
+	stopCtx := context.WithoutCancel(ctx)
+	_ = f.longPolling.Stop(stopCtx)
+	_ = f.webhook.Stop(stopCtx)
+	if state.longPollingDone != nil {
+		<-state.longPollingDone
+	}
+	<-state.webhookDone
+
+	f.lock.Lock()
+	f.err = err
+	f.stop = nil
+	f.running = false
+	f.lock.Unlock()
+}
+
+// forward forwards updates to failover update chan until source is stopped, once failover is stopped, remaining
+// updates are discarded, returned chan is closed once source update chan is closed
+func (s *failoverState) forward(source <-chan Update, lastUpdateID *atomic.Int64) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for update := range source {
+			select {
+			case s.updates <- update:
+				if lastUpdateID != nil {
+					lastUpdateID.Store(int64(update.UpdateID))
+				}
+			case <-s.quit:
+			}
+		}
+	}()
+	return done
+}
+
+// check checks webhook endpoint and switches sources if needed, returns an error if none of sources can be used
+func (f *FailoverSource) check(ctx context.Context, state *failoverState) error {
+	bot := f.webhook.bot
+
+	callCtx, cancel := context.WithTimeout(ctx, failoverCallTimeout)
+	defer cancel()
+
+	checkErr := f.config.Probe(callCtx)
+	if state.longPollingDone == nil && checkErr == nil {
+		info, err := bot.GetWebhookInfo(callCtx)
+		if err != nil {
+			bot.logAttrs(ctx, slog.LevelError, "Failover getting webhook info", slog.Any(LogKeyError, err))
+		} else if info.LastErrorDate > state.lastCheck.Unix() && info.PendingUpdateCount > 0 {
+			checkErr = fmt.Errorf("delivery error: %s", info.LastErrorMessage)
+		}
+	}
+	state.lastCheck = time.Now()
+
+	if checkErr != nil {
+		state.failures++
+		state.successes = 0
+	} else {
+		state.failures = 0
+		state.successes++
+	}
+
+	switch {
+	case state.longPollingDone == nil && state.failures >= f.config.FailureThreshold:
+		return f.fallback(callCtx, state, checkErr)
+	case state.longPollingDone != nil && state.successes >= f.config.RecoveryThreshold:
+		return f.recoverWebhook(callCtx, state)
+	default:
+		return nil
+	}
+}
+
+// fallback deletes webhook and starts long polling, webhook is set again if long polling can't be started
+func (f *FailoverSource) fallback(ctx context.Context, state *failoverState, reason error) error {
+	bot := f.webhook.bot
+	bot.logAttrs(ctx, slog.LevelWarn, "Failover webhook is unreachable, falling back to long polling",
+		slog.Any(LogKeyError, reason))
+
+	if err := bot.DeleteWebhook(ctx, nil); err != nil {
+		bot.logAttrs(ctx, slog.LevelError, "Failover deleting webhook", slog.Any(LogKeyError, err))
+		return nil
+	}
+
+	if err := f.startLongPolling(ctx, state); err != nil {
+		bot.logAttrs(ctx, slog.LevelError, "Failover starting long polling", slog.Any(LogKeyError, err))
+		if err = f.setWebhook(ctx); err != nil {
+			return fmt.Errorf("telego: failover: set webhook: %w", err)
+		}
+		return nil
+	}
+
+	state.successes = 0
+	f.switchTo(f.longPolling, reason)
+	return nil
+}
+
+// recoverWebhook stops long polling and sets webhook again, long polling is started again if webhook can't be set
+func (f *FailoverSource) recoverWebhook(ctx context.Context, state *failoverState) error {
+	bot := f.webhook.bot
+	bot.logAttrs(ctx, slog.LevelInfo, "Failover webhook recovered, switching back from long polling")
+
+	usesOffsetStore := f.longPolling.usesOffsetStore()
+
+	// Long polling is stopped before setting webhook, otherwise it will fail because of conflict
+	_ = f.longPolling.Stop(ctx)
+	<-state.longPollingDone
+	state.longPollingDone = nil
+
+	// Updates received via long polling are confirmed, otherwise Telegram will send them again to webhook,
+	// with offset store only processed updates are confirmed by long polling itself
+	if lastUpdateID := state.lastUpdateID.Load(); lastUpdateID >= 0 && !usesOffsetStore {
+		_, err := bot.GetUpdates(ctx, &GetUpdatesParams{Offset: int(lastUpdateID) + 1, Limit: 1})
+		if err != nil {
+			bot.logAttrs(ctx, slog.LevelError, "Failover confirming updates", slog.Any(LogKeyError, err))
+		}
+	}
+
+	if err := f.setWebhook(ctx); err != nil {
+		bot.logAttrs(ctx, slog.LevelError, "Failover setting webhook", slog.Any(LogKeyError, err))
+		if err = f.startLongPolling(ctx, state); err != nil {
+			return fmt.Errorf("telego: failover: start long polling: %w", err)
+		}
+		state.successes = 0
+		return nil
+	}
+
+	state.failures = 0
+	f.switchTo(f.webhook, nil)
+	return nil
+}
+
+// startLongPolling starts long polling and forwarding of its updates
+func (f *FailoverSource) startLongPolling(ctx context.Context, state *failoverState) error {
+	updates, err := f.longPolling.Start(context.WithoutCancel(ctx))
+	if err != nil {
+		return err
+	}
+
+	state.lastUpdateID.Store(-1)
+	state.longPollingDone = state.forward(updates, &state.lastUpdateID)
+	return nil
+}
+
+// setWebhook sets webhook again with params of webhook source
+func (f *FailoverSource) setWebhook(ctx context.Context) error {
+	webhookCtx := f.webhook.webhookContext()
+	if webhookCtx == nil {
+		return errors.New("webhook stopped")
+	}
+
+	return f.webhook.bot.reregisterWebhook(ctx, webhookCtx, *f.webhook.params)
+}
+
+// switchTo sets active source and calls switch callback
+func (f *FailoverSource) switchTo(active UpdateSource, reason error) {
+	f.lock.Lock()
+	f.active = active
+	f.lock.Unlock()
+
+	if f.config.OnSwitch != nil {
+		f.config.OnSwitch(active, reason)
+	}
+}
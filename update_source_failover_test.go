@@ -0,0 +1,414 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+func TestNewFailoverSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := newMockedBot(ctrl)
+	other := newMockedBot(ctrl)
+
+	params := &SetWebhookParams{URL: "https://example.com/bot"}
+	webhook := NewWebhookSource(m.Bot, "/bot", testAddress(t), params)
+	longPolling := NewLongPollingSource(m.Bot, nil)
+
+	t.Run("success", func(t *testing.T) {
+		f, err := NewFailoverSource(webhook, longPolling, FailoverConfig{})
+		require.NoError(t, err)
+
+		var _ UpdateSource = f
+		assert.Equal(t, defaultFailoverCheckInterval, f.config.CheckInterval)
+		assert.Equal(t, defaultFailoverFailureThreshold, f.config.FailureThreshold)
+		assert.Equal(t, defaultFailoverRecoveryThreshold, f.config.RecoveryThreshold)
+		assert.NotNil(t, f.config.Probe)
+		assert.Nil(t, f.Active())
+		assert.NoError(t, f.Err())
+		require.NoError(t, f.Stop(context.Background()))
+	})
+
+	tests := []struct {
+		name        string
+		webhook     *WebhookSource
+		longPolling *LongPollingSource
+		config      FailoverConfig
+	}{
+		{
+			name:        "error_nil_sources",
+			longPolling: longPolling,
+		},
+		{
+			name:        "error_no_params",
+			webhook:     NewWebhookSource(m.Bot, "/bot", testAddress(t), nil),
+			longPolling: longPolling,
+		},
+		{
+			name:        "error_different_bots",
+			webhook:     webhook,
+			longPolling: NewLongPollingSource(other.Bot, nil),
+		},
+		{
+			name:        "error_negative",
+			webhook:     webhook,
+			longPolling: longPolling,
+			config:      FailoverConfig{FailureThreshold: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFailoverSource(tt.webhook, tt.longPolling, tt.config)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestProbeWebhookURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer srv.Close()
+
+	require.NoError(t, probeWebhookURL(srv.URL)(context.Background()))
+	require.Error(t, probeWebhookURL("http://127.0.0.1:1")(context.Background()))
+	require.Error(t, probeWebhookURL("\n")(context.Background()))
+}
+
+// failoverTestAPI represents Bot API used by failover tests
+type failoverTestAPI struct {
+	lock         sync.Mutex
+	methods      []string
+	getUpdates   []GetUpdatesParams
+	update       atomic.Int32
+	getUpdatesFn func() (*ta.Response, error)
+}
+
+func (a *failoverTestAPI) expect(t *testing.T, m mockedBot) {
+	t.Helper()
+
+	m.MockRequestConstructor.EXPECT().
+		JSONRequest(gomock.Any()).
+		DoAndReturn(func(parameters any) (*ta.RequestData, error) {
+			if params, ok := parameters.(*GetUpdatesParams); ok {
+				a.lock.Lock()
+				a.getUpdates = append(a.getUpdates, *params)
+				a.lock.Unlock()
+			}
+			return data, nil
+		}).
+		AnyTimes()
+
+	m.MockAPICaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, url string, _ *ta.RequestData) (*ta.Response, error) {
+			method := path.Base(url)
+
+			a.lock.Lock()
+			a.methods = append(a.methods, method)
+			a.lock.Unlock()
+
+			switch method {
+			case "getWebhookInfo":
+				return telegoResponse(t, WebhookInfo{URL: "https://example.com/bot"}), nil
+			case "getUpdates":
+				if a.getUpdatesFn != nil {
+					return a.getUpdatesFn()
+				}
+				if updateID := a.update.Swap(0); updateID != 0 {
+					return telegoResponse(t, []Update{{UpdateID: int(updateID)}}), nil
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(time.Millisecond):
+				}
+				return telegoResponse(t, []Update{}), nil
+			default:
+				return emptyResp, nil
+			}
+		}).
+		AnyTimes()
+}
+
+func (a *failoverTestAPI) calls() ([]string, []GetUpdatesParams) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return slices.Clone(a.methods), slices.Clone(a.getUpdates)
+}
+
+func TestFailoverSource(t *testing.T) {
+	params := &SetWebhookParams{URL: "https://example.com/bot"}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		api := &failoverTestAPI{}
+		api.expect(t, m)
+
+		srv := &fasthttp.Server{}
+		webhook := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, WithWebhookServer(FastHTTPWebhookServer{
+			Server: srv,
+			Router: router.New(),
+		}))
+		longPolling := NewLongPollingSource(m.Bot, nil)
+
+		var reachable atomic.Bool
+		reachable.Store(true)
+
+		switches := make(chan UpdateSource, 8)
+		f, err := NewFailoverSource(webhook, longPolling, FailoverConfig{
+			CheckInterval:     time.Millisecond * 10,
+			FailureThreshold:  1,
+			RecoveryThreshold: 1,
+			Probe: func(_ context.Context) error {
+				if reachable.Load() {
+					return nil
+				}
+				return errTest
+			},
+			OnSwitch: func(active UpdateSource, reason error) {
+				if active == longPolling {
+					assert.ErrorIs(t, reason, errTest)
+				} else {
+					assert.NoError(t, reason)
+				}
+				switches <- active
+			},
+		})
+		require.NoError(t, err)
+
+		updates, err := f.Start(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, webhook, f.Active())
+
+		_, err = f.Start(context.Background())
+		require.Error(t, err)
+
+		waitSwitch := func() UpdateSource {
+			select {
+			case active := <-switches:
+				return active
+			case <-time.After(time.Second * 2):
+				t.Fatal("source was not switched")
+				return nil
+			}
+		}
+
+		assert.Eventually(t, func() bool {
+			methods, _ := api.calls()
+			return slices.Contains(methods, "getWebhookInfo")
+		}, time.Second, time.Millisecond)
+
+		api.update.Store(5)
+		reachable.Store(false)
+		assert.Equal(t, longPolling, waitSwitch())
+		assert.Equal(t, longPolling, f.Active())
+
+		update := <-updates
+		assert.Equal(t, 5, update.UpdateID)
+
+		reachable.Store(true)
+		assert.Equal(t, webhook, waitSwitch())
+		assert.Equal(t, webhook, f.Active())
+		assert.False(t, m.Bot.IsRunningLongPolling())
+
+		methods, getUpdates := api.calls()
+		assert.Equal(t, "setWebhook", methods[0])
+		assert.Contains(t, methods, "deleteWebhook")
+		assert.Equal(t, "setWebhook", methods[len(methods)-1])
+		assert.Equal(t, GetUpdatesParams{Offset: 6, Limit: 1}, getUpdates[len(getUpdates)-1])
+
+		go func() {
+			ctx := &fasthttp.RequestCtx{}
+			ctx.Request.SetRequestURI("/bot")
+			ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+			ctx.Request.SetBody([]byte(`{"update_id":7}`))
+			srv.Handler(ctx)
+		}()
+
+		update = <-updates
+		assert.Equal(t, 7, update.UpdateID)
+
+		require.NoError(t, f.Stop(context.Background()))
+		require.NoError(t, f.Stop(context.Background()))
+
+		_, ok := <-updates
+		assert.False(t, ok)
+		assert.NoError(t, f.Err())
+		assert.False(t, m.Bot.IsRunningWebhook())
+	})
+
+	t.Run("error_long_polling", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		api := &failoverTestAPI{
+			getUpdatesFn: func() (*ta.Response, error) {
+				return &ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 409, Description: "Conflict"}}, nil
+			},
+		}
+		api.expect(t, m)
+
+		webhook := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, WithWebhookServer(FastHTTPWebhookServer{
+			Server: &fasthttp.Server{},
+			Router: router.New(),
+		}))
+
+		longPolling := NewLongPollingSource(m.Bot, nil, WithLongPollingFatalErrors(IsFatalLongPollingError))
+
+		f, err := NewFailoverSource(webhook, longPolling, FailoverConfig{
+			CheckInterval:    time.Millisecond * 10,
+			FailureThreshold: 1,
+			Probe:            func(_ context.Context) error { return errTest },
+		})
+		require.NoError(t, err)
+
+		updates, err := f.Start(context.Background())
+		require.NoError(t, err)
+
+		for range updates {
+		}
+		require.ErrorIs(t, f.Err(), ta.ErrConflict)
+		assert.False(t, m.Bot.IsRunningWebhook())
+		assert.False(t, m.Bot.IsRunningLongPolling())
+	})
+
+	t.Run("context_done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		api := &failoverTestAPI{}
+		api.expect(t, m)
+
+		webhook := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, WithWebhookServer(FastHTTPWebhookServer{
+			Server: &fasthttp.Server{},
+			Router: router.New(),
+		}))
+
+		f, err := NewFailoverSource(webhook, NewLongPollingSource(m.Bot, nil), FailoverConfig{
+			CheckInterval: time.Millisecond,
+			Probe:         func(_ context.Context) error { return nil },
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		updates, err := f.Start(ctx)
+		require.NoError(t, err)
+
+		cancel()
+		for range updates {
+		}
+		assert.NoError(t, f.Err())
+	})
+
+	t.Run("error_webhook", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		api := &failoverTestAPI{}
+		api.expect(t, m)
+
+		webhook := NewWebhookSource(m.Bot, "/bot", "invalid", params, WithWebhookServer(FastHTTPWebhookServer{
+			Server: &fasthttp.Server{},
+			Router: router.New(),
+		}))
+
+		f, err := NewFailoverSource(webhook, NewLongPollingSource(m.Bot, nil), FailoverConfig{})
+		require.NoError(t, err)
+
+		updates, err := f.Start(context.Background())
+		require.NoError(t, err)
+
+		for range updates {
+		}
+		require.Error(t, f.Err())
+	})
+
+	t.Run("error_watchdog", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		api := &failoverTestAPI{}
+		api.expect(t, m)
+
+		webhook := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, WithWebhookServer(FastHTTPWebhookServer{
+			Server: &fasthttp.Server{},
+			Router: router.New(),
+		}), WithWebhookWatchdog(params, WebhookWatchdogConfig{}))
+
+		f, err := NewFailoverSource(webhook, NewLongPollingSource(m.Bot, nil), FailoverConfig{})
+		require.NoError(t, err)
+
+		_, err = f.Start(context.Background())
+		require.Error(t, err)
+		assert.False(t, m.Bot.IsRunningWebhook())
+
+		methods, _ := api.calls()
+		assert.Empty(t, methods)
+	})
+
+	t.Run("context_done_webhook_stopped", func(t *testing.T) {
+		// Run loop may see webhook source stopped because of canceled context before it sees context done, that
+		// should not be reported as failure, repeated few times to make both cases happen
+		for range 10 {
+			ctrl := gomock.NewController(t)
+			m := newMockedBot(ctrl)
+
+			api := &failoverTestAPI{}
+			api.expect(t, m)
+
+			webhook := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, WithWebhookServer(FastHTTPWebhookServer{
+				Server: &fasthttp.Server{},
+				Router: router.New(),
+			}))
+
+			// Probe holds the run loop until webhook source is stopped
+			probing := make(chan struct{}, 1)
+			release := make(chan struct{})
+			f, err := NewFailoverSource(webhook, NewLongPollingSource(m.Bot, nil), FailoverConfig{
+				CheckInterval: time.Millisecond,
+				Probe: func(_ context.Context) error {
+					select {
+					case probing <- struct{}{}:
+					default:
+					}
+					<-release
+					return nil
+				},
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			updates, err := f.Start(ctx)
+			require.NoError(t, err)
+
+			<-probing
+			cancel()
+			assert.Eventually(t, func() bool { return webhook.webhookContext() == nil }, time.Second, time.Millisecond)
+			close(release)
+
+			for range updates {
+			}
+			assert.NoError(t, f.Err())
+			assert.False(t, m.Bot.IsRunningWebhook())
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package telego
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+func TestLongPollingSource(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).
+			AnyTimes()
+
+		var calls atomic.Int32
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ *ta.RequestData) (*ta.Response, error) {
+				if calls.Add(1) == 1 {
+					return telegoResponse(t, []Update{{UpdateID: 1}}), nil
+				}
+				time.Sleep(time.Millisecond)
+				return telegoResponse(t, []Update{}), nil
+			}).
+			AnyTimes()
+
+		var _ UpdateSource = &LongPollingSource{}
+		s := NewLongPollingSource(m.Bot, &GetUpdatesParams{Timeout: 1})
+
+		updates, err := s.Start(context.Background())
+		require.NoError(t, err)
+		assert.True(t, m.Bot.IsRunningLongPolling())
+
+		_, err = s.Start(context.Background())
+		require.Error(t, err)
+
+		update := <-updates
+		assert.Equal(t, 1, update.UpdateID)
+
+		require.NoError(t, s.Stop(context.Background()))
+		require.NoError(t, s.Stop(context.Background()))
+		for range updates {
+		}
+
+		assert.False(t, m.Bot.IsRunningLongPolling())
+		assert.NoError(t, s.Err())
+		assert.Nil(t, s.ctx)
+
+		updates, err = s.Start(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, s.Stop(context.Background()))
+		for range updates {
+		}
+	})
+
+	t.Run("fatal_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{Ok: false, Error: &ta.Error{ErrorCode: 401, Description: "Unauthorized"}}, nil)
+
+		s := NewLongPollingSource(m.Bot, nil, WithLongPollingFatalErrors(IsFatalLongPollingError))
+		assert.NoError(t, s.Err())
+
+		updates, err := s.Start(context.Background())
+		require.NoError(t, err)
+
+		_, ok := <-updates
+		assert.False(t, ok)
+
+		var lpErr *LongPollingError
+		require.ErrorAs(t, s.Err(), &lpErr)
+		assert.True(t, lpErr.Fatal)
+		require.ErrorIs(t, s.Err(), ta.ErrUnauthorized)
+	})
+
+	t.Run("context_done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(data, nil).
+			AnyTimes()
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ *ta.RequestData) (*ta.Response, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).
+			AnyTimes()
+
+		s := NewLongPollingSource(m.Bot, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		updates, err := s.Start(ctx)
+		require.NoError(t, err)
+
+		cancel()
+		_, ok := <-updates
+		assert.False(t, ok)
+
+		assert.Eventually(t, func() bool {
+			return !m.Bot.IsRunningLongPolling()
+		}, time.Second, time.Millisecond)
+		assert.NoError(t, s.Err())
+	})
+}
+
+func TestWebhookSource(t *testing.T) {
+	params := &SetWebhookParams{URL: "https://example.com/bot"}
+
+	newServer := func() (*fasthttp.Server, WebhookOption) {
+		srv := &fasthttp.Server{}
+		return srv, WithWebhookServer(FastHTTPWebhookServer{
+			Server: srv,
+			Router: router.New(),
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(params).
+			Return(data, nil)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(emptyResp, nil)
+
+		srv, server := newServer()
+		var _ UpdateSource = &WebhookSource{}
+		s := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, server)
+
+		updates, err := s.Start(context.Background())
+		require.NoError(t, err)
+		assert.True(t, m.Bot.IsRunningWebhook())
+
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/bot")
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		ctx.Request.SetBody([]byte(`{"update_id":1}`))
+		srv.Handler(ctx)
+		require.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+
+		update := <-updates
+		assert.Equal(t, 1, update.UpdateID)
+
+		require.NoError(t, s.Stop(context.Background()))
+		require.NoError(t, s.Stop(context.Background()))
+
+		_, ok := <-updates
+		assert.False(t, ok)
+		assert.False(t, m.Bot.IsRunningWebhook())
+		assert.NoError(t, s.Err())
+	})
+
+	t.Run("context_done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		_, server := newServer()
+		s := NewWebhookSource(m.Bot, "/bot", testAddress(t), nil, server)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		updates, err := s.Start(ctx)
+		require.NoError(t, err)
+
+		cancel()
+		_, ok := <-updates
+		assert.False(t, ok)
+		assert.NoError(t, s.Err())
+	})
+
+	t.Run("error_server", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		_, server := newServer()
+		s := NewWebhookSource(m.Bot, "/bot", "invalid", nil, server)
+
+		updates, err := s.Start(context.Background())
+		require.NoError(t, err)
+
+		_, ok := <-updates
+		assert.False(t, ok)
+		assert.Eventually(t, func() bool {
+			return s.Err() != nil
+		}, time.Second, time.Millisecond)
+		require.NoError(t, s.Stop(context.Background()))
+	})
+
+	t.Run("error_set_webhook", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(params).
+			Return(nil, errTest)
+
+		_, server := newServer()
+		s := NewWebhookSource(m.Bot, "/bot", testAddress(t), params, server)
+
+		_, err := s.Start(context.Background())
+		require.ErrorIs(t, err, errTest)
+		assert.Nil(t, m.Bot.webhookContext)
+		require.NoError(t, s.Stop(context.Background()))
+	})
+
+	t.Run("error_options", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		s := NewWebhookSource(m.Bot, "/bot", testAddress(t), nil, WithWebhookServer(nil))
+
+		_, err := s.Start(context.Background())
+		require.Error(t, err)
+	})
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
@@ -38,6 +41,17 @@ type webhookContext struct {
 	server WebhookServer
 
 	updateChanBuffer uint
+	replyTimeout     time.Duration
+	tls              *webhookTLS
+	watchdog         *webhookWatchdog
+
+	updates  chan Update
+	overflow WebhookOverflowPolicy
+	spilled  chan struct{}
+	dropped  atomic.Uint64
+	rejected atomic.Uint64
+
+	spillFailed atomic.Bool
 }
 
 // WebhookOption represents an option that can be applied to webhookContext
@@ -63,11 +77,11 @@ func WithWebhookServer(server WebhookServer) WebhookOption {
 	}
 }
 
-// WithWebhookSet calls [Bot.SetWebhook] method before starting webhook
+// WithWebhookSet calls [Bot.SetWebhook] method before starting webhook, provided context is used for the call
 // Note: Calling [Bot.SetWebhook] method multiple times in a row may give "too many requests" errors
-func WithWebhookSet(params *SetWebhookParams) WebhookOption {
+func WithWebhookSet(ctx context.Context, params *SetWebhookParams) WebhookOption {
 	return func(bot *Bot, _ *webhookContext) error {
-		return bot.SetWebhook(params)
+		return bot.SetWebhook(ctx, params)
 	}
 }
 
@@ -96,14 +110,17 @@ func (b *Bot) UpdatesViaWebhook(path string, options ...WebhookOption) (<-chan U
 	webhookCtx.configured = true
 
 	updatesChan := make(chan Update, webhookCtx.updateChanBuffer)
+	webhookCtx.updates = updatesChan
+	webhookCtx.spilled = make(chan struct{}, 1)
 
 	err = webhookCtx.server.RegisterHandler(path, func(ctx context.Context, data []byte) error {
-		b.log.Debugf("Webhook request with data: %s", string(data))
+		if b.logEnabled(ctx, slog.LevelDebug) {
+			b.logAttrs(ctx, slog.LevelDebug, "Webhook request", slog.String(LogKeyData, string(data)))
+		}
 
 		var update Update
-		err = json.Unmarshal(data, &update)
-		if err != nil {
-			b.log.Errorf("Webhook decoding error: %s", err)
+		if err := json.Unmarshal(data, &update); err != nil {
+			b.logAttrs(ctx, slog.LevelError, "Webhook decoding error", slog.Any(LogKeyError, err))
 			return fmt.Errorf("telego: webhook decoding update: %w", err)
 		}
 
@@ -113,9 +130,22 @@ func (b *Bot) UpdatesViaWebhook(path string, options ...WebhookOption) (<-chan U
 		case <-ctx.Done():
 			return fmt.Errorf("telego: webhook handler context: %w", ctx.Err())
 		default:
-			if safeSend(updatesChan, update.WithContext(ctx)) {
-				return errWebhookStopped
+			b.handleChatMigrationUpdate(update)
+
+			response, ok := webhookResponseFromContext(ctx)
+			var reply *webhookReply
+			if webhookCtx.replyTimeout > 0 && ok {
+				reply = newWebhookReply()
+				update.done = reply.close
+				update.reply = reply.reply
+			}
+
+			sent, err := b.deliverWebhookUpdate(ctx, webhookCtx, update.WithContext(ctx), data)
+			if err != nil || !sent || reply == nil {
+				return err
 			}
+
+			response.body = reply.wait(webhookCtx.replyTimeout, webhookCtx.stop)
 			return nil
 		}
 	})
@@ -123,8 +153,27 @@ func (b *Bot) UpdatesViaWebhook(path string, options ...WebhookOption) (<-chan U
 		return nil, fmt.Errorf("telego: webhook register handler: %w", err)
 	}
 
+	if webhookCtx.watchdog != nil && webhookCtx.watchdog.config.ReadinessPath != "" {
+		err = registerWebhookReadiness(webhookCtx.server, webhookCtx.watchdog.config.ReadinessPath,
+			webhookCtx.watchdog.ready)
+		if err != nil {
+			return nil, fmt.Errorf("telego: webhook register readiness handler: %w", err)
+		}
+	}
+
+	drained := make(chan struct{})
+	if webhookCtx.overflow.kind == webhookOverflowSpill {
+		go func() {
+			defer close(drained)
+			b.drainWebhookSpill(webhookCtx)
+		}()
+	} else {
+		close(drained)
+	}
+
 	go func() {
 		<-webhookCtx.stop
+		<-drained
 		close(updatesChan)
 	}()
 
@@ -147,6 +196,14 @@ func (b *Bot) createWebhookContext(options []WebhookOption) (*webhookContext, er
 		}
 	}
 
+	if ctx.tls != nil {
+		server, err := ctx.tls.setup(b, ctx.server)
+		if err != nil {
+			return nil, fmt.Errorf("telego: webhook TLS: %w", err)
+		}
+		ctx.server = server
+	}
+
 	return ctx, nil
 }
 
@@ -161,21 +218,38 @@ func (b *Bot) StartWebhook(address string) error {
 		return errors.New("telego: webhook context does not exist")
 	}
 
-	ctx.runningLock.RLock()
+	if err := ctx.markRunning(); err != nil {
+		return err
+	}
+
+	return b.serveWebhook(ctx, address)
+}
+
+// markRunning marks webhook as running, returns an error if it's not configured or already running
+func (ctx *webhookContext) markRunning() error {
+	ctx.runningLock.Lock()
+	defer ctx.runningLock.Unlock()
+
 	if !ctx.configured {
-		ctx.runningLock.RUnlock()
 		return errors.New("telego: webhook context not configured")
 	}
 
 	if ctx.running {
-		ctx.runningLock.RUnlock()
 		return errors.New("telego: webhook already running")
 	}
-	ctx.runningLock.RUnlock()
 
-	ctx.runningLock.Lock()
 	ctx.running = true
-	ctx.runningLock.Unlock()
+	return nil
+}
+
+// serveWebhook starts webhook server of running webhook, blocking operation
+func (b *Bot) serveWebhook(ctx *webhookContext, address string) error {
+	if ctx.tls != nil {
+		go b.renewWebhookCertificate(ctx)
+	}
+	if ctx.watchdog != nil {
+		go b.runWebhookWatchdog(ctx)
+	}
 
 	if err := ctx.server.Start(address); err != nil {
 		ctx.runningLock.Lock()
@@ -183,7 +257,9 @@ func (b *Bot) StartWebhook(address string) error {
 			close(ctx.stop)
 			ctx.running = false
 		}
-		b.webhookContext = nil
+		if b.webhookContext == ctx {
+			b.webhookContext = nil
+		}
 		ctx.runningLock.Unlock()
 
 		return err
@@ -215,20 +291,27 @@ func (b *Bot) StopWebhookWithContext(ctx context.Context) error {
 		return nil
 	}
 
+	return b.stopWebhook(ctx, webhookCtx)
+}
+
+// stopWebhook shutdown webhook server of provided webhook context, resets webhook context of bot if it's the same
+func (b *Bot) stopWebhook(ctx context.Context, webhookCtx *webhookContext) error {
 	webhookCtx.runningLock.Lock()
 	defer webhookCtx.runningLock.Unlock()
 
+	if b.webhookContext == webhookCtx {
+		b.webhookContext = nil
+	}
+
 	if webhookCtx.running {
 		err := webhookCtx.server.Stop(ctx)
 
 		close(webhookCtx.stop)
 		webhookCtx.running = false
 
-		b.webhookContext = nil
 		return err
 	}
 
-	b.webhookContext = nil
 	return nil
 }
 
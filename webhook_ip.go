@@ -0,0 +1,128 @@
+package telego
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// ForwardedForHeader represents header with a chain of client and proxies addresses set by proxies
+	ForwardedForHeader = "X-Forwarded-For"
+
+	// RealIPHeader represents header with client address set by proxy
+	RealIPHeader = "X-Real-IP"
+)
+
+// TelegramWebhookSubnets represents subnets from which Telegram sends webhook requests,
+// see https://core.telegram.org/bots/webhooks#the-short-version
+var TelegramWebhookSubnets = []netip.Prefix{
+	netip.MustParsePrefix("149.154.160.0/20"),
+	netip.MustParsePrefix("91.108.4.0/22"),
+}
+
+// WebhookIPAllowlist restricts webhook requests to allowed IP addresses, requests from other addresses are rejected
+// with 403 status code and counted.
+// Note: Zero value is ready to use, it allows only [TelegramWebhookSubnets] and doesn't trust any proxies.
+//
+// Warning: Allowlist must be shared by pointer, since it counts rejected requests.
+type WebhookIPAllowlist struct {
+	// Allowed - Allowed subnets, [TelegramWebhookSubnets] are used if empty
+	Allowed []netip.Prefix
+
+	// TrustedProxies - Subnets of proxies (like load balancers) that are trusted to set [ForwardedForHeader] and
+	// [RealIPHeader] headers, if request came from trusted proxy, client address is taken from headers
+	TrustedProxies []netip.Prefix
+
+	rejected atomic.Uint64
+}
+
+// Rejected returns number of rejected requests
+func (a *WebhookIPAllowlist) Rejected() uint64 {
+	return a.rejected.Load()
+}
+
+// Allow reports whether request is allowed and returns resolved client address, rejected requests are counted.
+// Values of forwarded for and real IP headers are used only if remote address belongs to trusted proxy, if request
+// has multiple forwarded for headers, their values must be joined with commas in order they were received.
+func (a *WebhookIPAllowlist) Allow(remoteAddr, forwardedFor, realIP string) (netip.Addr, bool) {
+	ip, err := a.ClientIP(remoteAddr, forwardedFor, realIP)
+	if err != nil || !a.isAllowed(ip) {
+		a.rejected.Add(1)
+		return ip, false
+	}
+	return ip, true
+}
+
+// ClientIP returns client address of request, if remote address belongs to trusted proxy the rightmost not trusted
+// address from forwarded for header is used (or real IP header if forwarded for header is empty)
+func (a *WebhookIPAllowlist) ClientIP(remoteAddr, forwardedFor, realIP string) (netip.Addr, error) {
+	ip, err := parseRemoteAddr(remoteAddr)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if !containsAddr(a.TrustedProxies, ip) {
+		return ip, nil
+	}
+
+	if strings.TrimSpace(forwardedFor) != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip, err = netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				return netip.Addr{}, fmt.Errorf("invalid %s header: %w", ForwardedForHeader, err)
+			}
+			ip = ip.Unmap()
+
+			if !containsAddr(a.TrustedProxies, ip) {
+				return ip, nil
+			}
+		}
+		return ip, nil
+	}
+
+	if realIP = strings.TrimSpace(realIP); realIP != "" {
+		ip, err = netip.ParseAddr(realIP)
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("invalid %s header: %w", RealIPHeader, err)
+		}
+		return ip.Unmap(), nil
+	}
+
+	return ip, nil
+}
+
+// isAllowed reports whether address belongs to allowed subnets
+func (a *WebhookIPAllowlist) isAllowed(ip netip.Addr) bool {
+	if len(a.Allowed) == 0 {
+		return containsAddr(TelegramWebhookSubnets, ip)
+	}
+	return containsAddr(a.Allowed, ip)
+}
+
+// parseRemoteAddr parses remote address with or without port
+func parseRemoteAddr(remoteAddr string) (netip.Addr, error) {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid remote address: %w", err)
+	}
+	return ip.Unmap(), nil
+}
+
+// containsAddr reports whether address belongs to any of subnets
+func containsAddr(subnets []netip.Prefix, ip netip.Addr) bool {
+	for _, subnet := range subnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
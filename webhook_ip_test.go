@@ -0,0 +1,136 @@
+package telego
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookIPAllowlist_ClientIP(t *testing.T) {
+	a := &WebhookIPAllowlist{
+		TrustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		realIP       string
+		ip           string
+		isErr        bool
+	}{
+		{
+			name:       "remote_addr",
+			remoteAddr: "149.154.160.1:443",
+			ip:         "149.154.160.1",
+		},
+		{
+			name:       "remote_addr_without_port",
+			remoteAddr: "149.154.160.1",
+			ip:         "149.154.160.1",
+		},
+		{
+			name:       "remote_addr_ipv4_mapped",
+			remoteAddr: "[::ffff:149.154.160.1]:443",
+			ip:         "149.154.160.1",
+		},
+		{
+			name:         "untrusted_proxy_headers_ignored",
+			remoteAddr:   "1.2.3.4:443",
+			forwardedFor: "149.154.160.1",
+			realIP:       "149.154.160.1",
+			ip:           "1.2.3.4",
+		},
+		{
+			name:         "forwarded_for",
+			remoteAddr:   "10.0.0.1:443",
+			forwardedFor: "1.2.3.4, 149.154.160.1, 10.0.0.2",
+			realIP:       "5.6.7.8",
+			ip:           "149.154.160.1",
+		},
+		{
+			name:         "forwarded_for_only_proxies",
+			remoteAddr:   "10.0.0.1:443",
+			forwardedFor: "10.0.0.3, 10.0.0.2",
+			ip:           "10.0.0.3",
+		},
+		{
+			name:       "real_ip",
+			remoteAddr: "10.0.0.1:443",
+			realIP:     "149.154.160.1",
+			ip:         "149.154.160.1",
+		},
+		{
+			name:       "trusted_proxy_without_headers",
+			remoteAddr: "10.0.0.1:443",
+			ip:         "10.0.0.1",
+		},
+		{
+			name:       "error_remote_addr",
+			remoteAddr: "invalid",
+			isErr:      true,
+		},
+		{
+			name:         "error_forwarded_for",
+			remoteAddr:   "10.0.0.1:443",
+			forwardedFor: "1.2.3.4, invalid",
+			isErr:        true,
+		},
+		{
+			name:       "error_real_ip",
+			remoteAddr: "10.0.0.1:443",
+			realIP:     "invalid",
+			isErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, err := a.ClientIP(tt.remoteAddr, tt.forwardedFor, tt.realIP)
+			if tt.isErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, netip.MustParseAddr(tt.ip), ip)
+		})
+	}
+}
+
+func TestWebhookIPAllowlist_Allow(t *testing.T) {
+	t.Run("telegram_subnets", func(t *testing.T) {
+		a := &WebhookIPAllowlist{}
+
+		_, ok := a.Allow("149.154.167.220:443", "", "")
+		assert.True(t, ok)
+
+		_, ok = a.Allow("91.108.4.1:443", "", "")
+		assert.True(t, ok)
+
+		ip, ok := a.Allow("1.2.3.4:443", "", "")
+		assert.False(t, ok)
+		assert.Equal(t, netip.MustParseAddr("1.2.3.4"), ip)
+
+		_, ok = a.Allow("invalid", "", "")
+		assert.False(t, ok)
+
+		assert.Equal(t, uint64(2), a.Rejected())
+	})
+
+	t.Run("custom_subnets", func(t *testing.T) {
+		a := &WebhookIPAllowlist{
+			Allowed: []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")},
+		}
+
+		_, ok := a.Allow("192.0.2.1:1234", "", "")
+		assert.True(t, ok)
+
+		_, ok = a.Allow("149.154.160.1:443", "", "")
+		assert.False(t, ok)
+
+		assert.Equal(t, uint64(1), a.Rejected())
+	})
+}
@@ -0,0 +1,464 @@
+package telego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mymmrac/telego/internal/json"
+)
+
+// errWebhookOverflow returned if update can't be delivered because update chan is full
+var errWebhookOverflow = errors.New("telego: webhook update chan is full")
+
+// errWebhookSpillFailed returned if update can't be spilled because updates can't be read from spill queue
+var errWebhookSpillFailed = errors.New("telego: webhook spill queue can't be read")
+
+// WebhookStatusError represents webhook handler error that should be responded with specific HTTP status code,
+// for example, 503 or 429 to make Telegram deliver update later. Other errors are responded with 500.
+type WebhookStatusError struct {
+	StatusCode int
+	Err        error
+}
+
+// Error returns error message
+func (e *WebhookStatusError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Err)
+}
+
+// Unwrap returns underlying error
+func (e *WebhookStatusError) Unwrap() error {
+	return e.Err
+}
+
+// webhookErrorStatusCode returns HTTP status code of webhook handler error
+func webhookErrorStatusCode(err error) int {
+	var statusErr *WebhookStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode != 0 {
+		return statusErr.StatusCode
+	}
+	return http.StatusInternalServerError
+}
+
+// webhookOverflowKind represents kind of overflow policy
+type webhookOverflowKind int
+
+const (
+	webhookOverflowBlock webhookOverflowKind = iota
+	webhookOverflowReject
+	webhookOverflowDrop
+	webhookOverflowSpill
+)
+
+// WebhookOverflowPolicy represents policy of handling incoming webhook updates when update chan is full,
+// zero value blocks request until update is received from chan (default)
+type WebhookOverflowPolicy struct {
+	kind       webhookOverflowKind
+	timeout    time.Duration
+	statusCode int
+	spill      WebhookSpillQueue
+}
+
+// WebhookOverflowBlock blocks request until update is received from chan, if timeout is positive and passes,
+// request is responded with 503 status code, so Telegram will deliver update later
+func WebhookOverflowBlock(timeout time.Duration) WebhookOverflowPolicy {
+	return WebhookOverflowPolicy{
+		kind:    webhookOverflowBlock,
+		timeout: timeout,
+	}
+}
+
+// WebhookOverflowReject immediately responds with provided status code (like 503 or 429), so Telegram will deliver
+// update later
+func WebhookOverflowReject(statusCode int) WebhookOverflowPolicy {
+	return WebhookOverflowPolicy{
+		kind:       webhookOverflowReject,
+		statusCode: statusCode,
+	}
+}
+
+// WebhookOverflowDrop drops update and responds with 200 status code, dropped updates are counted in
+// [WebhookQueueStats.Dropped] and logged
+func WebhookOverflowDrop() WebhookOverflowPolicy {
+	return WebhookOverflowPolicy{
+		kind: webhookOverflowDrop,
+	}
+}
+
+// WebhookOverflowSpill stores update in spill queue and responds with 200 status code, updates from spill queue are
+// sent to update chan in order they were received once there is free space.
+// Note: Once there are updates in spill queue, all new updates are spilled too to preserve order. While updates
+// can't be read from spill queue, reading is retried with backoff and new updates are rejected with 503 status code.
+func WebhookOverflowSpill(queue WebhookSpillQueue) WebhookOverflowPolicy {
+	return WebhookOverflowPolicy{
+		kind:  webhookOverflowSpill,
+		spill: queue,
+	}
+}
+
+// WithWebhookOverflow sets policy of handling updates when update chan is full. Default is to block request until
+// update is received from chan.
+func WithWebhookOverflow(policy WebhookOverflowPolicy) WebhookOption {
+	return func(_ *Bot, ctx *webhookContext) error {
+		switch policy.kind {
+		case webhookOverflowBlock:
+			if policy.timeout < 0 {
+				return fmt.Errorf("webhook overflow timeout should not be negative, got %s", policy.timeout)
+			}
+		case webhookOverflowReject:
+			if policy.statusCode < http.StatusBadRequest || policy.statusCode > 599 {
+				return fmt.Errorf("webhook overflow status code should be an error code, got %d", policy.statusCode)
+			}
+		case webhookOverflowSpill:
+			if policy.spill == nil {
+				return errors.New("webhook overflow spill queue is nil")
+			}
+		}
+
+		ctx.overflow = policy
+		return nil
+	}
+}
+
+// WebhookQueueStats represents occupancy of webhook update queues and overflow counters
+type WebhookQueueStats struct {
+	// Length - Number of updates waiting in update chan
+	Length int
+
+	// Capacity - Capacity of update chan
+	Capacity int
+
+	// Spilled - Number of updates waiting in spill queue
+	Spilled int
+
+	// Dropped - Total number of updates dropped because of overflow
+	Dropped uint64
+
+	// Rejected - Total number of updates rejected (or timed out) because of overflow
+	Rejected uint64
+}
+
+// WebhookQueueStats returns occupancy of webhook update queues and overflow counters, zero stats are returned if
+// webhook is not configured
+func (b *Bot) WebhookQueueStats() WebhookQueueStats {
+	ctx := b.webhookContext
+	if ctx == nil || ctx.updates == nil {
+		return WebhookQueueStats{}
+	}
+
+	stats := WebhookQueueStats{
+		Length:   len(ctx.updates),
+		Capacity: cap(ctx.updates),
+		Dropped:  ctx.dropped.Load(),
+		Rejected: ctx.rejected.Load(),
+	}
+	if ctx.overflow.spill != nil {
+		stats.Spilled = ctx.overflow.spill.Len()
+	}
+
+	return stats
+}
+
+// deliverWebhookUpdate sends update to update chan applying overflow policy, returns true if update was sent
+func (b *Bot) deliverWebhookUpdate(ctx context.Context, webhookCtx *webhookContext, update Update, data []byte,
+) (bool, error) {
+	policy := webhookCtx.overflow
+
+	if policy.kind == webhookOverflowSpill {
+		if webhookCtx.spillFailed.Load() {
+			webhookCtx.rejected.Add(1)
+			return false, &WebhookStatusError{StatusCode: http.StatusServiceUnavailable, Err: errWebhookSpillFailed}
+		}
+
+		if policy.spill.Len() > 0 {
+			return false, b.spillWebhookUpdate(ctx, webhookCtx, update, data)
+		}
+	}
+
+	sent, closed := trySend(webhookCtx.updates, update)
+	if closed {
+		return false, errWebhookStopped
+	}
+	if sent {
+		return true, nil
+	}
+
+	switch policy.kind {
+	case webhookOverflowReject:
+		webhookCtx.rejected.Add(1)
+		return false, &WebhookStatusError{StatusCode: policy.statusCode, Err: errWebhookOverflow}
+	case webhookOverflowDrop:
+		webhookCtx.dropped.Add(1)
+		b.logAttrs(ctx, slog.LevelWarn, "Webhook update dropped", slog.Int(LogKeyUpdateID, update.UpdateID))
+		return false, nil
+	case webhookOverflowSpill:
+		return false, b.spillWebhookUpdate(ctx, webhookCtx, update, data)
+	default:
+		var timeout <-chan time.Time
+		if policy.timeout > 0 {
+			timer := time.NewTimer(policy.timeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+
+		sent, closed = sendOrDone(webhookCtx.updates, update, webhookCtx.stop, timeout)
+		if closed {
+			return false, errWebhookStopped
+		}
+		if !sent {
+			webhookCtx.rejected.Add(1)
+			return false, &WebhookStatusError{StatusCode: http.StatusServiceUnavailable, Err: errWebhookOverflow}
+		}
+		return true, nil
+	}
+}
+
+// spillWebhookUpdate stores update in spill queue
+func (b *Bot) spillWebhookUpdate(ctx context.Context, webhookCtx *webhookContext, update Update, data []byte) error {
+	if err := webhookCtx.overflow.spill.Push(data); err != nil {
+		webhookCtx.rejected.Add(1)
+		b.logAttrs(ctx, slog.LevelError, "Webhook update spill error",
+			slog.Int(LogKeyUpdateID, update.UpdateID), slog.Any(LogKeyError, err))
+		return &WebhookStatusError{
+			StatusCode: http.StatusServiceUnavailable,
+			Err:        fmt.Errorf("telego: webhook spill update: %w", err),
+		}
+	}
+
+	select {
+	case webhookCtx.spilled <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// drainWebhookSpill sends updates from spill queue to update chan until webhook is stopped, reading is retried with
+// backoff until it succeeds
+func (b *Bot) drainWebhookSpill(webhookCtx *webhookContext) {
+	spill := webhookCtx.overflow.spill
+	retryInterval := webhookSpillRetryInterval
+	for {
+		data, ok, err := spill.Peek()
+		if err != nil {
+			webhookCtx.spillFailed.Store(true)
+			b.logAttrs(context.Background(), slog.LevelError, "Webhook spill read error", slog.Any(LogKeyError, err))
+
+			select {
+			case <-webhookCtx.stop:
+				return
+			case <-time.After(retryInterval):
+				retryInterval = min(retryInterval*2, webhookSpillMaxRetryInterval)
+				continue
+			}
+		}
+
+		retryInterval = webhookSpillRetryInterval
+		webhookCtx.spillFailed.Store(false)
+
+		if !ok {
+			select {
+			case <-webhookCtx.stop:
+				return
+			case <-webhookCtx.spilled:
+				continue
+			case <-time.After(webhookSpillRetryInterval):
+				continue
+			}
+		}
+
+		var update Update
+		if err = json.Unmarshal(data, &update); err != nil {
+			b.logAttrs(context.Background(), slog.LevelError, "Webhook spill decoding error",
+				slog.Any(LogKeyError, err))
+		} else {
+			select {
+			case <-webhookCtx.stop:
+				return
+			case webhookCtx.updates <- update.WithContext(context.Background()):
+			}
+		}
+
+		if err = spill.Pop(); err != nil {
+			b.logAttrs(context.Background(), slog.LevelError, "Webhook spill remove error", slog.Any(LogKeyError, err))
+		}
+	}
+}
+
+// trySend sends value to chan without blocking, reports whether value was sent and whether chan is closed
+func trySend[T any](ch chan<- T, value T) (sent, closed bool) {
+	defer func() {
+		if recover() != nil {
+			closed = true
+		}
+	}()
+
+	select {
+	case ch <- value:
+		return true, false
+	default:
+		return false, false
+	}
+}
+
+// sendOrDone sends value to chan blocking until sent, done or timeout, reports whether value was sent and whether
+// chan is closed (or done)
+func sendOrDone[T any](ch chan<- T, value T, done <-chan struct{}, timeout <-chan time.Time) (sent, closed bool) {
+	defer func() {
+		if recover() != nil {
+			closed = true
+		}
+	}()
+
+	select {
+	case ch <- value:
+		return true, false
+	case <-done:
+		return false, true
+	case <-timeout:
+		return false, false
+	}
+}
+
+const (
+	// webhookSpillRetryInterval represents interval of checking spill queue after read error
+	webhookSpillRetryInterval = time.Second
+
+	// webhookSpillMaxRetryInterval represents max interval of checking spill queue after consecutive read errors
+	webhookSpillMaxRetryInterval = time.Second * 30
+)
+
+// WebhookSpillQueue represents FIFO queue of raw webhook updates used by [WebhookOverflowSpill] policy
+type WebhookSpillQueue interface {
+	// Push appends update to the end of queue
+	Push(data []byte) error
+
+	// Peek returns update from the front of queue without removing it, false if queue is empty
+	Peek() ([]byte, bool, error)
+
+	// Pop removes update from the front of queue
+	Pop() error
+
+	// Len returns number of updates in queue
+	Len() int
+}
+
+// DirWebhookSpillQueue disk implementation of [WebhookSpillQueue], each update is stored as a separate file in
+// directory, so spilled updates survive restarts
+type DirWebhookSpillQueue struct {
+	dir string
+
+	lock  sync.Mutex
+	files []uint64
+	next  uint64
+}
+
+// NewDirWebhookSpillQueue creates disk spill queue in directory, it's created if not exists and updates left from
+// previous runs are loaded
+func NewDirWebhookSpillQueue(dir string) (*DirWebhookSpillQueue, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("telego: create spill dir: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("telego: read spill dir: %w", err)
+	}
+
+	q := &DirWebhookSpillQueue{dir: dir}
+	for _, entry := range entries {
+		name, found := strings.CutSuffix(entry.Name(), webhookSpillFileExt)
+		if !found || entry.IsDir() {
+			continue
+		}
+
+		seq, parseErr := strconv.ParseUint(name, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+
+		q.files = append(q.files, seq)
+		q.next = max(q.next, seq+1)
+	}
+	slices.Sort(q.files)
+
+	return q, nil
+}
+
+// webhookSpillFileExt represents extension of spilled update files
+const webhookSpillFileExt = ".json"
+
+// Push writes update to a new file
+func (q *DirWebhookSpillQueue) Push(data []byte) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	seq := q.next
+	path := q.path(seq)
+
+	if err := os.WriteFile(path+".tmp", data, 0o600); err != nil {
+		return fmt.Errorf("write spill: %w", err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		_ = os.Remove(path + ".tmp")
+		return fmt.Errorf("rename spill: %w", err)
+	}
+
+	q.next++
+	q.files = append(q.files, seq)
+	return nil
+}
+
+// Peek reads the oldest update file
+func (q *DirWebhookSpillQueue) Peek() ([]byte, bool, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if len(q.files) == 0 {
+		return nil, false, nil
+	}
+
+	data, err := os.ReadFile(q.path(q.files[0]))
+	if err != nil {
+		return nil, false, fmt.Errorf("read spill: %w", err)
+	}
+
+	return data, true, nil
+}
+
+// Pop removes the oldest update file
+func (q *DirWebhookSpillQueue) Pop() error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if len(q.files) == 0 {
+		return nil
+	}
+
+	if err := os.Remove(q.path(q.files[0])); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove spill: %w", err)
+	}
+
+	q.files = q.files[1:]
+	return nil
+}
+
+// Len returns number of update files
+func (q *DirWebhookSpillQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.files)
+}
+
+// path returns path of update file with sequence number
+func (q *DirWebhookSpillQueue) path(seq uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", seq, webhookSpillFileExt))
+}
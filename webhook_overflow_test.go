@@ -0,0 +1,296 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestWithWebhookOverflow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctx := &webhookContext{}
+		err := WithWebhookOverflow(WebhookOverflowReject(http.StatusTooManyRequests))(nil, ctx)
+		require.NoError(t, err)
+		assert.Equal(t, WebhookOverflowReject(http.StatusTooManyRequests), ctx.overflow)
+	})
+
+	tests := []struct {
+		name   string
+		policy WebhookOverflowPolicy
+	}{
+		{
+			name:   "error_timeout",
+			policy: WebhookOverflowBlock(-time.Second),
+		},
+		{
+			name:   "error_status_code",
+			policy: WebhookOverflowReject(http.StatusOK),
+		},
+		{
+			name:   "error_spill",
+			policy: WebhookOverflowSpill(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithWebhookOverflow(tt.policy)(nil, &webhookContext{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestBot_UpdatesViaWebhook_Overflow(t *testing.T) {
+	spill, err := NewDirWebhookSpillQueue(t.TempDir())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		policy     WebhookOverflowPolicy
+		statusCode int
+		delivered  []int
+		stats      WebhookQueueStats
+	}{
+		{
+			name:       "block",
+			policy:     WebhookOverflowBlock(0),
+			statusCode: fasthttp.StatusOK,
+			delivered:  []int{1, 2},
+			stats:      WebhookQueueStats{Capacity: 1},
+		},
+		{
+			name:       "block_timeout",
+			policy:     WebhookOverflowBlock(time.Millisecond * 10),
+			statusCode: fasthttp.StatusServiceUnavailable,
+			delivered:  []int{1},
+			stats:      WebhookQueueStats{Capacity: 1, Rejected: 1},
+		},
+		{
+			name:       "reject",
+			policy:     WebhookOverflowReject(fasthttp.StatusTooManyRequests),
+			statusCode: fasthttp.StatusTooManyRequests,
+			delivered:  []int{1},
+			stats:      WebhookQueueStats{Capacity: 1, Rejected: 1},
+		},
+		{
+			name:       "drop",
+			policy:     WebhookOverflowDrop(),
+			statusCode: fasthttp.StatusOK,
+			delivered:  []int{1},
+			stats:      WebhookQueueStats{Capacity: 1, Dropped: 1},
+		},
+		{
+			name:       "spill",
+			policy:     WebhookOverflowSpill(spill),
+			statusCode: fasthttp.StatusOK,
+			delivered:  []int{1, 2},
+			stats:      WebhookQueueStats{Capacity: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := NewBot(token, WithDiscardLogger())
+			require.NoError(t, err)
+
+			srv := &fasthttp.Server{}
+			updates, err := b.UpdatesViaWebhook("/bot", WithWebhookServer(FastHTTPWebhookServer{
+				Server: srv,
+				Router: router.New(),
+			}), WithWebhookBuffer(1), WithWebhookOverflow(tt.policy))
+			require.NoError(t, err)
+
+			request := func(body string) *fasthttp.RequestCtx {
+				ctx := &fasthttp.RequestCtx{}
+				ctx.Request.SetRequestURI("/bot")
+				ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+				ctx.Request.SetBody([]byte(body))
+				return ctx
+			}
+
+			ctx := request(`{"update_id":1}`)
+			srv.Handler(ctx)
+			require.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+			assert.Equal(t, 1, b.WebhookQueueStats().Length)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				ctx = request(`{"update_id":2}`)
+				srv.Handler(ctx)
+			}()
+
+			if tt.policy.kind != webhookOverflowBlock || tt.policy.timeout > 0 {
+				<-done
+			}
+
+			var delivered []int
+			for range tt.delivered {
+				select {
+				case update := <-updates:
+					delivered = append(delivered, update.UpdateID)
+				case <-time.After(time.Second):
+					t.Fatal("update was not delivered")
+				}
+			}
+			<-done
+
+			assert.Equal(t, tt.statusCode, ctx.Response.StatusCode())
+			assert.Equal(t, tt.delivered, delivered)
+			assert.Eventually(t, func() bool {
+				return b.WebhookQueueStats() == tt.stats
+			}, time.Second, time.Millisecond)
+
+			close(b.webhookContext.stop)
+			_, ok := <-updates
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestBot_UpdatesViaWebhook_SpillReadError(t *testing.T) {
+	spill, err := NewDirWebhookSpillQueue(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, spill.Push([]byte(`{"update_id":1}`)))
+	require.NoError(t, spill.Push([]byte(`{"update_id":2}`)))
+
+	// Replace the first spilled update with directory, so it can't be read
+	unreadable := spill.path(spill.files[0])
+	require.NoError(t, os.Remove(unreadable))
+	require.NoError(t, os.Mkdir(unreadable, 0o750))
+
+	b, err := NewBot(token, WithDiscardLogger())
+	require.NoError(t, err)
+
+	srv := &fasthttp.Server{}
+	updates, err := b.UpdatesViaWebhook("/bot", WithWebhookServer(FastHTTPWebhookServer{
+		Server: srv,
+		Router: router.New(),
+	}), WithWebhookBuffer(1), WithWebhookOverflow(WebhookOverflowSpill(spill)))
+	require.NoError(t, err)
+
+	request := func(body string) int {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/bot")
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		ctx.Request.SetBody([]byte(body))
+		srv.Handler(ctx)
+		return ctx.Response.StatusCode()
+	}
+
+	require.Eventually(t, b.webhookContext.spillFailed.Load, time.Second, time.Millisecond)
+	assert.Equal(t, fasthttp.StatusServiceUnavailable, request(`{"update_id":3}`))
+
+	// Spilled update becomes readable again and is delivered without losing order
+	require.NoError(t, os.Remove(unreadable))
+	require.NoError(t, os.WriteFile(unreadable, []byte(`{"update_id":1}`), 0o600))
+
+	for _, updateID := range []int{1, 2} {
+		select {
+		case update := <-updates:
+			assert.Equal(t, updateID, update.UpdateID)
+		case <-time.After(webhookSpillRetryInterval * 2):
+			t.Fatal("update was not delivered")
+		}
+	}
+
+	assert.Eventually(t, func() bool {
+		return !b.webhookContext.spillFailed.Load()
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, fasthttp.StatusOK, request(`{"update_id":3}`))
+	assert.Equal(t, WebhookQueueStats{Length: 1, Capacity: 1, Rejected: 1}, b.WebhookQueueStats())
+
+	close(b.webhookContext.stop)
+	for range updates {
+	}
+}
+
+func TestBot_WebhookQueueStats(t *testing.T) {
+	b, err := NewBot(token, WithDiscardLogger())
+	require.NoError(t, err)
+
+	assert.Equal(t, WebhookQueueStats{}, b.WebhookQueueStats())
+}
+
+func TestWebhookStatusError(t *testing.T) {
+	err := &WebhookStatusError{StatusCode: http.StatusServiceUnavailable, Err: errTest}
+	assert.Equal(t, "503 Service Unavailable: "+errTest.Error(), err.Error())
+	assert.ErrorIs(t, err, errTest)
+
+	assert.Equal(t, http.StatusServiceUnavailable, webhookErrorStatusCode(err))
+	assert.Equal(t, http.StatusInternalServerError, webhookErrorStatusCode(errTest))
+
+	s := HTTPWebhookServer{
+		Server:   &http.Server{}, //nolint:gosec
+		ServeMux: http.NewServeMux(),
+	}
+	require.NoError(t, s.RegisterHandler("/", func(_ context.Context, _ []byte) error {
+		return err
+	}))
+
+	rc := httptest.NewRecorder()
+	s.Server.Handler.ServeHTTP(rc, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rc.Code)
+}
+
+func TestDirWebhookSpillQueue(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "spill")
+
+	q, err := NewDirWebhookSpillQueue(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, q.Len())
+
+	_, ok, err := q.Peek()
+	require.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, q.Pop())
+
+	require.NoError(t, q.Push([]byte("1")))
+	require.NoError(t, q.Push([]byte("2")))
+	require.NoError(t, q.Push([]byte("3")))
+	require.NoError(t, q.Pop())
+	assert.Equal(t, 2, q.Len())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json"), nil, 0o600))
+
+	q, err = NewDirWebhookSpillQueue(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, q.Len())
+
+	data, ok, err := q.Peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "2", string(data))
+
+	require.NoError(t, q.Push([]byte("4")))
+	require.NoError(t, q.Pop())
+
+	data, ok, err = q.Peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "3", string(data))
+
+	require.NoError(t, q.Pop())
+	data, ok, err = q.Peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "4", string(data))
+
+	t.Run("error_dir", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+		_, err = NewDirWebhookSpillQueue(file)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,144 @@
+package telego
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mymmrac/telego/internal/json"
+)
+
+// webhookResponseKey represents context key of webhook response
+type webhookResponseKey struct{}
+
+// webhookResponse represents response body set by webhook handler
+type webhookResponse struct {
+	body []byte
+}
+
+// WebhookResponseContext returns context for calling [WebhookHandler] and function that returns response body set by
+// the handler (nil if nothing was set). Used by implementations of [WebhookServer] to support webhook replies,
+// returned body should be written as JSON response, see [WithWebhookReplies] for more details.
+func WebhookResponseContext(ctx context.Context) (context.Context, func() []byte) {
+	response := &webhookResponse{}
+	return context.WithValue(ctx, webhookResponseKey{}, response), func() []byte {
+		return response.body
+	}
+}
+
+// webhookResponseFromContext returns webhook response from context if server supports webhook replies
+func webhookResponseFromContext(ctx context.Context) (*webhookResponse, bool) {
+	response, ok := ctx.Value(webhookResponseKey{}).(*webhookResponse)
+	return response, ok
+}
+
+// WithWebhookReplies allows answering webhook updates with method call in HTTP response using [Update.WebhookReply],
+// which saves a separate request to Telegram. Webhook handler waits up to the timeout for the reply or until update
+// is marked as processed using [Update.Done] (whatever happens first), so timeout should be small.
+// Note: Only servers that use [WebhookResponseContext] support replies ([FastHTTPWebhookServer] and
+// [HTTPWebhookServer] do), for other servers [Update.WebhookReply] always returns false.
+//
+// Warning: Updates are acknowledged to Telegram only after the reply, timeout or [Update.Done], so make sure
+// bot handler (or update processing code) calls [Update.Done] and processes updates concurrently, otherwise each
+// update will hold Telegram's request for the whole timeout.
+func WithWebhookReplies(timeout time.Duration) WebhookOption {
+	return func(_ *Bot, ctx *webhookContext) error {
+		if timeout <= 0 {
+			return fmt.Errorf("webhook reply timeout should be positive, got %s", timeout)
+		}
+
+		ctx.replyTimeout = timeout
+		return nil
+	}
+}
+
+// webhookReply represents pending reply to webhook update
+type webhookReply struct {
+	lock    sync.Mutex
+	closed  bool
+	body    []byte
+	replied chan struct{}
+}
+
+// newWebhookReply creates pending webhook reply
+func newWebhookReply() *webhookReply {
+	return &webhookReply{
+		replied: make(chan struct{}),
+	}
+}
+
+// reply sets reply body, returns false if reply is no longer accepted
+func (r *webhookReply) reply(body []byte) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return false
+	}
+
+	r.body = body
+	r.closed = true
+	close(r.replied)
+	return true
+}
+
+// close stops accepting reply
+func (r *webhookReply) close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.closed {
+		r.closed = true
+		close(r.replied)
+	}
+}
+
+// wait waits for reply until timeout or stop, returns reply body or nil if there is no reply
+func (r *webhookReply) wait(timeout time.Duration, stop <-chan struct{}) []byte {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-r.replied:
+	case <-timer.C:
+	case <-stop:
+	}
+	r.close()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.body
+}
+
+// webhookReplyBody returns JSON body of method call used as webhook reply
+func webhookReplyBody(method string, params any) ([]byte, error) {
+	if _, hasFiles := filesParameters(params); hasFiles {
+		return nil, fmt.Errorf("method %q has files to upload", method)
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if !isNil(params) {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("marshal params: %w", err)
+		}
+
+		if err = json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("unmarshal params: %w", err)
+		}
+	}
+
+	methodData, err := json.Marshal(method)
+	if err != nil {
+		return nil, fmt.Errorf("marshal method: %w", err)
+	}
+	fields["method"] = methodData
+
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("marshal reply: %w", err)
+	}
+
+	return body, nil
+}
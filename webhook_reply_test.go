@@ -0,0 +1,176 @@
+package telego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestWithWebhookReplies(t *testing.T) {
+	ctx := &webhookContext{}
+
+	t.Run("success", func(t *testing.T) {
+		err := WithWebhookReplies(time.Second)(nil, ctx)
+		require.NoError(t, err)
+		assert.Equal(t, time.Second, ctx.replyTimeout)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := WithWebhookReplies(0)(nil, ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestBot_UpdatesViaWebhook_Replies(t *testing.T) {
+	b, err := NewBot(token, WithDiscardLogger())
+	require.NoError(t, err)
+
+	srv := &fasthttp.Server{}
+	updates, err := b.UpdatesViaWebhook("/bot", WithWebhookServer(FastHTTPWebhookServer{
+		Server: srv,
+		Router: router.New(),
+	}), WithWebhookReplies(time.Millisecond*100))
+	require.NoError(t, err)
+
+	request := func() *fasthttp.RequestCtx {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/bot")
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		ctx.Request.SetBody([]byte(`{"update_id":1}`))
+		return ctx
+	}
+
+	t.Run("reply", func(t *testing.T) {
+		go func() {
+			update := <-updates
+			assert.True(t, update.WebhookReply("sendMessage", &SendMessageParams{
+				ChatID: ChatID{ID: 1},
+				Text:   "ok",
+			}))
+			assert.False(t, update.WebhookReply("sendMessage", &SendMessageParams{}))
+		}()
+
+		ctx := request()
+		srv.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.Equal(t, webhookResponseContentType, string(ctx.Response.Header.ContentType()))
+		assert.JSONEq(t, `{"method":"sendMessage","chat_id":1,"text":"ok"}`, string(ctx.Response.Body()))
+	})
+
+	t.Run("done", func(t *testing.T) {
+		go func() {
+			update := <-updates
+			update.Done()
+			assert.False(t, update.WebhookReply("sendMessage", &SendMessageParams{}))
+		}()
+
+		start := time.Now()
+		ctx := request()
+		srv.Handler(ctx)
+
+		assert.Less(t, time.Since(start), time.Millisecond*100)
+		assert.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.Empty(t, ctx.Response.Body())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		replied := make(chan bool)
+		go func() {
+			update := <-updates
+			time.Sleep(time.Millisecond * 200)
+			replied <- update.WebhookReply("sendMessage", &SendMessageParams{})
+		}()
+
+		ctx := request()
+		srv.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.Empty(t, ctx.Response.Body())
+		assert.False(t, <-replied)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		go func() {
+			update := <-updates
+			assert.False(t, update.WebhookReply("sendPhoto", &SendPhotoParams{
+				ChatID: ChatID{ID: 1},
+				Photo:  InputFile{File: testNamedReade{}},
+			}))
+			update.Done()
+		}()
+
+		ctx := request()
+		srv.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+		assert.Empty(t, ctx.Response.Body())
+	})
+
+	close(b.webhookContext.stop)
+}
+
+func TestHTTPWebhookServer_Reply(t *testing.T) {
+	s := HTTPWebhookServer{
+		Logger:   testLoggerType{},
+		Server:   &http.Server{}, //nolint:gosec
+		ServeMux: http.NewServeMux(),
+	}
+
+	err := s.RegisterHandler("/", func(ctx context.Context, data []byte) error {
+		response, ok := webhookResponseFromContext(ctx)
+		require.True(t, ok)
+
+		if len(data) != 0 {
+			response.body = data
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	t.Run("reply", func(t *testing.T) {
+		rc := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":"getMe"}`))
+
+		s.Server.Handler.ServeHTTP(rc, req)
+
+		assert.Equal(t, http.StatusOK, rc.Code)
+		assert.Equal(t, webhookResponseContentType, rc.Header().Get("Content-Type"))
+		assert.Equal(t, `{"method":"getMe"}`, rc.Body.String())
+	})
+
+	t.Run("no_reply", func(t *testing.T) {
+		rc := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+
+		s.Server.Handler.ServeHTTP(rc, req)
+
+		assert.Equal(t, http.StatusOK, rc.Code)
+		assert.Empty(t, rc.Body.String())
+	})
+}
+
+func TestUpdate_WebhookReply(t *testing.T) {
+	t.Run("not_enabled", func(t *testing.T) {
+		assert.False(t, Update{}.WebhookReply("getMe", nil))
+	})
+
+	t.Run("no_params", func(t *testing.T) {
+		var body []byte
+		update := Update{reply: func(data []byte) bool {
+			body = data
+			return true
+		}}
+
+		assert.True(t, update.Clone().WebhookReply("getMe", nil))
+		assert.JSONEq(t, `{"method":"getMe"}`, string(body))
+	})
+}
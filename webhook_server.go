@@ -1,10 +1,13 @@
 package telego
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/fasthttp/router"
@@ -14,17 +17,27 @@ import (
 // WebhookSecretTokenHeader represents secret token header name, see [SetWebhookParams.SecretToken] for more details
 const WebhookSecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token" //nolint:gosec
 
+// webhookResponseContentType represents content type of webhook reply
+const webhookResponseContentType = "application/json"
+
 // FastHTTPWebhookServer represents fasthttp implementation of [WebhookServer].
-// The Server and Router are required fields, optional Logger and SecretToken can be provided.
+// The Server and Router are required fields, optional Logger, SecretToken, IPAllowlist and TLSConfig can be provided.
+// If TLSConfig is set, server serves HTTPS using it.
 type FastHTTPWebhookServer struct {
 	Logger      Logger
 	Server      *fasthttp.Server
 	Router      *router.Router
 	SecretToken string
+	IPAllowlist *WebhookIPAllowlist
+	TLSConfig   *tls.Config
 }
 
 // Start starts server
 func (f FastHTTPWebhookServer) Start(address string) error {
+	if f.TLSConfig != nil {
+		f.Server.TLSConfig = f.TLSConfig
+		return f.Server.ListenAndServeTLS(address, "", "")
+	}
 	return f.Server.ListenAndServe(address)
 }
 
@@ -33,10 +46,31 @@ func (f FastHTTPWebhookServer) Stop(ctx context.Context) error {
 	return f.Server.ShutdownWithContext(ctx)
 }
 
+// withTLSConfig returns server that serves HTTPS using provided TLS config
+func (f FastHTTPWebhookServer) withTLSConfig(config *tls.Config) (WebhookServer, error) {
+	f.TLSConfig = config
+	return f, nil
+}
+
 // RegisterHandler registers new POST handler for the desired path
 // Note: If server's handler is not set, it will be set to router's handler
 func (f FastHTTPWebhookServer) RegisterHandler(path string, handler WebhookHandler) error {
 	f.Router.POST(path, func(ctx *fasthttp.RequestCtx) {
+		if f.IPAllowlist != nil {
+			// All forwarded for headers are joined, since proxies may append separate header instead of extending it
+			forwardedFor := string(bytes.Join(ctx.Request.Header.PeekAll(ForwardedForHeader), []byte(",")))
+			ip, ok := f.IPAllowlist.Allow(ctx.RemoteAddr().String(), forwardedFor,
+				string(ctx.Request.Header.Peek(RealIPHeader)))
+			if !ok {
+				if f.Logger != nil {
+					f.Logger.Errorf("Webhook handler: forbidden: IP %q is not allowed", ip)
+				}
+
+				ctx.SetStatusCode(fasthttp.StatusForbidden)
+				return
+			}
+		}
+
 		if f.SecretToken != "" {
 			secretToken := ctx.Request.Header.Peek(WebhookSecretTokenHeader)
 			if f.SecretToken != string(secretToken) {
@@ -49,15 +83,21 @@ func (f FastHTTPWebhookServer) RegisterHandler(path string, handler WebhookHandl
 			}
 		}
 
-		if err := handler(context.WithoutCancel(ctx), ctx.PostBody()); err != nil {
+		handlerCtx, response := WebhookResponseContext(context.WithoutCancel(ctx))
+		if err := handler(handlerCtx, ctx.PostBody()); err != nil {
 			if f.Logger != nil {
 				f.Logger.Errorf("Webhook handler: %s", err)
 			}
 
-			ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+			ctx.SetStatusCode(webhookErrorStatusCode(err))
 			return
 		}
 
+		if body := response(); body != nil {
+			ctx.SetContentType(webhookResponseContentType)
+			ctx.SetBody(body)
+		}
+
 		ctx.SetStatusCode(fasthttp.StatusOK)
 	})
 
@@ -68,13 +108,31 @@ func (f FastHTTPWebhookServer) RegisterHandler(path string, handler WebhookHandl
 	return nil
 }
 
+// registerReadinessHandler registers GET handler of readiness endpoint
+func (f FastHTTPWebhookServer) registerReadinessHandler(path string, ready func() (bool, string)) error {
+	f.Router.GET(path, func(ctx *fasthttp.RequestCtx) {
+		statusCode, body := readinessResponse(ready)
+		ctx.SetStatusCode(statusCode)
+		ctx.SetBodyString(body)
+	})
+
+	if f.Server.Handler == nil {
+		f.Server.Handler = f.Router.Handler
+	}
+
+	return nil
+}
+
 // HTTPWebhookServer represents http implementation of [WebhookServer].
-// The Server and ServeMux are required fields, optional Logger and SecretToken can be provided.
+// The Server and ServeMux are required fields, optional Logger, SecretToken, IPAllowlist and TLSConfig can be
+// provided. If TLSConfig is set, server serves HTTPS using it.
 type HTTPWebhookServer struct {
 	Logger      Logger
 	Server      *http.Server
 	ServeMux    *http.ServeMux
 	SecretToken string
+	IPAllowlist *WebhookIPAllowlist
+	TLSConfig   *tls.Config
 }
 
 // Start starts server
@@ -82,7 +140,15 @@ func (h HTTPWebhookServer) Start(address string) error {
 	if h.Server.Addr == "" {
 		h.Server.Addr = address
 	}
-	if err := h.Server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+
+	var err error
+	if h.TLSConfig != nil {
+		h.Server.TLSConfig = h.TLSConfig
+		err = h.Server.ListenAndServeTLS("", "")
+	} else {
+		err = h.Server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
 
@@ -94,6 +160,12 @@ func (h HTTPWebhookServer) Stop(ctx context.Context) error {
 	return h.Server.Shutdown(ctx)
 }
 
+// withTLSConfig returns server that serves HTTPS using provided TLS config
+func (h HTTPWebhookServer) withTLSConfig(config *tls.Config) (WebhookServer, error) {
+	h.TLSConfig = config
+	return h, nil
+}
+
 // RegisterHandler registers new POST handler for the desired path
 // Note: If server's handler is not set, it will be set to serve mux handler
 func (h HTTPWebhookServer) RegisterHandler(path string, handler WebhookHandler) error {
@@ -108,12 +180,22 @@ func (h HTTPWebhookServer) RegisterHandler(path string, handler WebhookHandler)
 			return
 		}
 
-		if err = handler(context.WithoutCancel(request.Context()), data); err != nil {
+		handlerCtx, response := WebhookResponseContext(context.WithoutCancel(request.Context()))
+		if err = handler(handlerCtx, data); err != nil {
 			if h.Logger != nil {
 				h.Logger.Errorf("Webhook handler: %s", err)
 			}
 
-			writer.WriteHeader(http.StatusInternalServerError)
+			writer.WriteHeader(webhookErrorStatusCode(err))
+			return
+		}
+
+		if body := response(); body != nil {
+			writer.Header().Set("Content-Type", webhookResponseContentType)
+			writer.WriteHeader(http.StatusOK)
+			if _, err = writer.Write(body); err != nil && h.Logger != nil {
+				h.Logger.Errorf("Webhook handler: write response: %s", err)
+			}
 			return
 		}
 
@@ -127,12 +209,45 @@ func (h HTTPWebhookServer) RegisterHandler(path string, handler WebhookHandler)
 	return nil
 }
 
+// registerReadinessHandler registers GET handler of readiness endpoint
+func (h HTTPWebhookServer) registerReadinessHandler(path string, ready func() (bool, string)) error {
+	h.ServeMux.HandleFunc(path, func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet && request.Method != http.MethodHead {
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		statusCode, body := readinessResponse(ready)
+		writer.WriteHeader(statusCode)
+		_, _ = io.WriteString(writer, body)
+	})
+
+	if h.Server.Handler == nil {
+		h.Server.Handler = h.ServeMux
+	}
+
+	return nil
+}
+
 func (h HTTPWebhookServer) validateRequest(writer http.ResponseWriter, request *http.Request) bool {
 	if request.Method != http.MethodPost {
 		writer.WriteHeader(http.StatusMethodNotAllowed)
 		return false
 	}
 
+	if h.IPAllowlist != nil {
+		forwardedFor := strings.Join(request.Header.Values(ForwardedForHeader), ",")
+		ip, ok := h.IPAllowlist.Allow(request.RemoteAddr, forwardedFor, request.Header.Get(RealIPHeader))
+		if !ok {
+			if h.Logger != nil {
+				h.Logger.Errorf("Webhook handler: forbidden: IP %q is not allowed", ip)
+			}
+
+			writer.WriteHeader(http.StatusForbidden)
+			return false
+		}
+	}
+
 	if h.SecretToken != "" {
 		secretToken := request.Header.Get(WebhookSecretTokenHeader)
 		if h.SecretToken != secretToken {
@@ -199,6 +314,22 @@ func (m *MultiBotWebhookServer) RegisterHandler(path string, handler WebhookHand
 	return m.Server.RegisterHandler(path, handler)
 }
 
+// withTLSConfig configures underlying server to serve HTTPS using provided TLS config
+func (m *MultiBotWebhookServer) withTLSConfig(config *tls.Config) (WebhookServer, error) {
+	server, err := webhookServerWithTLSConfig(m.Server, config)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Server = server
+	return m, nil
+}
+
+// registerReadinessHandler registers readiness endpoint on underlying server
+func (m *MultiBotWebhookServer) registerReadinessHandler(path string, ready func() (bool, string)) error {
+	return registerWebhookReadiness(m.Server, path, ready)
+}
+
 // NoOpWebhookServer represents no-op implementation of [WebhookServer],
 // suitable for cases when you want to have full control over start & stop of server manually
 type NoOpWebhookServer struct {
@@ -253,3 +384,19 @@ func (f FuncWebhookServer) RegisterHandler(path string, handler WebhookHandler)
 	}
 	return f.Server.RegisterHandler(path, handler)
 }
+
+// withTLSConfig returns server with underlying server configured to serve HTTPS using provided TLS config
+func (f FuncWebhookServer) withTLSConfig(config *tls.Config) (WebhookServer, error) {
+	server, err := webhookServerWithTLSConfig(f.Server, config)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Server = server
+	return f, nil
+}
+
+// registerReadinessHandler registers readiness endpoint on underlying server
+func (f FuncWebhookServer) registerReadinessHandler(path string, ready func() (bool, string)) error {
+	return registerWebhookReadiness(f.Server, path, ready)
+}
@@ -3,8 +3,10 @@ package telego
 import (
 	"context"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"strings"
 	"testing"
 	"time"
@@ -84,6 +86,72 @@ func TestFastHTTPWebhookServer_RegisterHandler(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFastHTTPWebhookServer_IPAllowlist(t *testing.T) {
+	s := FastHTTPWebhookServer{
+		Logger: testLoggerType{},
+		Server: &fasthttp.Server{},
+		Router: router.New(),
+		IPAllowlist: &WebhookIPAllowlist{
+			TrustedProxies: []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")},
+		},
+	}
+
+	err := s.RegisterHandler("/", func(_ context.Context, _ []byte) error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	newCtx := func(ip string) *fasthttp.RequestCtx {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Init(&fasthttp.Request{}, &net.TCPAddr{IP: net.ParseIP(ip), Port: 443}, nil)
+		ctx.Request.SetRequestURI("/")
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		return ctx
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := newCtx("149.154.160.1")
+		s.Server.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := newCtx("1.2.3.4")
+		s.Server.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusForbidden, ctx.Response.StatusCode())
+	})
+
+	t.Run("forbidden_untrusted_forwarded_for", func(t *testing.T) {
+		ctx := newCtx("1.2.3.4")
+		ctx.Request.Header.Set(ForwardedForHeader, "149.154.160.1")
+		s.Server.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusForbidden, ctx.Response.StatusCode())
+	})
+
+	t.Run("forbidden_multiple_forwarded_for", func(t *testing.T) {
+		ctx := newCtx("192.0.2.1")
+		ctx.Request.Header.Add(ForwardedForHeader, "149.154.160.1")
+		ctx.Request.Header.Add(ForwardedForHeader, "1.2.3.4")
+		s.Server.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusForbidden, ctx.Response.StatusCode())
+	})
+
+	t.Run("success_multiple_forwarded_for", func(t *testing.T) {
+		ctx := newCtx("192.0.2.1")
+		ctx.Request.Header.Add(ForwardedForHeader, "1.2.3.4")
+		ctx.Request.Header.Add(ForwardedForHeader, "149.154.160.1, 192.0.2.2")
+		s.Server.Handler(ctx)
+
+		assert.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+	})
+
+	assert.Equal(t, uint64(3), s.IPAllowlist.Rejected())
+}
+
 func TestHTTPWebhookServer_RegisterHandler(t *testing.T) {
 	require.Implements(t, (*WebhookServer)(nil), HTTPWebhookServer{})
 
@@ -189,6 +257,76 @@ func TestHTTPWebhookServer_RegisterHandler(t *testing.T) {
 		err = s.Stop(context.Background())
 		require.NoError(t, err)
 	})
+
+	t.Run("ip_allowlist", func(t *testing.T) {
+		s := HTTPWebhookServer{
+			Logger:   testLoggerType{},
+			Server:   &http.Server{}, //nolint:gosec
+			ServeMux: http.NewServeMux(),
+			IPAllowlist: &WebhookIPAllowlist{
+				TrustedProxies: []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")},
+			},
+		}
+
+		err := s.RegisterHandler("/", func(_ context.Context, _ []byte) error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		t.Run("success", func(t *testing.T) {
+			rc := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = "149.154.160.1:443"
+
+			s.Server.Handler.ServeHTTP(rc, req)
+
+			assert.Equal(t, http.StatusOK, rc.Code)
+		})
+
+		t.Run("success_trusted_proxy", func(t *testing.T) {
+			rc := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set(ForwardedForHeader, "149.154.160.1")
+
+			s.Server.Handler.ServeHTTP(rc, req)
+
+			assert.Equal(t, http.StatusOK, rc.Code)
+		})
+
+		t.Run("forbidden", func(t *testing.T) {
+			rc := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = "1.2.3.4:443"
+			req.Header.Set(RealIPHeader, "149.154.160.1")
+
+			s.Server.Handler.ServeHTTP(rc, req)
+
+			assert.Equal(t, http.StatusForbidden, rc.Code)
+		})
+
+		t.Run("forbidden_multiple_forwarded_for", func(t *testing.T) {
+			rc := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Add(ForwardedForHeader, "149.154.160.1")
+			req.Header.Add(ForwardedForHeader, "1.2.3.4")
+
+			s.Server.Handler.ServeHTTP(rc, req)
+
+			assert.Equal(t, http.StatusForbidden, rc.Code)
+		})
+
+		t.Run("forbidden_trusted_proxy", func(t *testing.T) {
+			rc := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set(RealIPHeader, "1.2.3.4")
+
+			s.Server.Handler.ServeHTTP(rc, req)
+
+			assert.Equal(t, http.StatusForbidden, rc.Code)
+		})
+
+		assert.Equal(t, uint64(3), s.IPAllowlist.Rejected())
+	})
 }
 
 type errReader struct{}
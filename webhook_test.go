@@ -2,6 +2,7 @@ package telego
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"testing"
 	"time"
@@ -201,6 +202,8 @@ func TestBot_UpdatesViaWebhook(t *testing.T) {
 		update, ok := <-updates
 		require.True(t, ok)
 		update.ctx = nil
+		assert.JSONEq(t, string(expectedUpdateBytes), string(update.Raw()))
+		update.raw = nil
 
 		assert.Equal(t, expectedUpdate, update)
 
@@ -283,8 +286,8 @@ func TestWithWebhookSet(t *testing.T) {
 		Buffer: bytes.NewBuffer(nil),
 	}, nil)
 
-	m.MockAPICaller.EXPECT().Call(gomock.Any(), gomock.Any()).Return(&ta.Response{Ok: true}, nil)
+	m.MockAPICaller.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ta.Response{Ok: true}, nil)
 
-	err := WithWebhookSet(&SetWebhookParams{})(m.Bot, ctx)
+	err := WithWebhookSet(context.Background(), &SetWebhookParams{})(m.Bot, ctx)
 	require.NoError(t, err)
 }
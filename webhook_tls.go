@@ -0,0 +1,403 @@
+package telego
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mymmrac/telego/internal/atomicfile"
+)
+
+// WebhookPorts represents ports allowed by Telegram for webhook URL
+var WebhookPorts = []int{443, 80, 88, 8443}
+
+const (
+	defaultWebhookCertValidity    = time.Hour * 24 * 365
+	defaultWebhookCertRenewBefore = time.Hour * 24 * 30
+	webhookCertRetryInterval      = time.Minute
+	webhookCertKeyBits            = 2048
+	webhookCertFileName           = "certificate.pem"
+)
+
+// WebhookTLSConfig represents configuration of self-signed certificate used to serve webhook over HTTPS
+type WebhookTLSConfig struct {
+	// CertFile - Optional path to PEM encoded certificate, loaded if exists, otherwise generated certificate is
+	// saved to it, must be set together with KeyFile
+	CertFile string
+
+	// KeyFile - Optional path to PEM encoded private key, loaded if exists, otherwise generated key is saved to it,
+	// must be set together with CertFile
+	KeyFile string
+
+	// Validity - Validity period of generated certificates. Default is 365 days.
+	Validity time.Duration
+
+	// RenewBefore - Period before certificate expiry when a new certificate is generated and webhook is set again.
+	// Default is 30 days (or half of Validity if it's shorter).
+	RenewBefore time.Duration
+}
+
+// WithWebhookSelfSignedTLS serves webhook over HTTPS using self-signed certificate and calls [Bot.SetWebhook] method
+// with its public part as [SetWebhookParams.Certificate], provided context is used for the call. Certificate is
+// loaded from files or generated for host of [SetWebhookParams.URL], which must use HTTPS and one of [WebhookPorts].
+// Certificate is renewed before expiry while webhook is running, and webhook is set again with the new one.
+// Note: Only [FastHTTPWebhookServer] and [HTTPWebhookServer] (also wrapped in [MultiBotWebhookServer] or
+// [FuncWebhookServer]) are supported.
+func WithWebhookSelfSignedTLS(ctx context.Context, params *SetWebhookParams, config WebhookTLSConfig) WebhookOption {
+	return func(_ *Bot, webhookCtx *webhookContext) error {
+		if params == nil {
+			return errors.New("webhook params are nil")
+		}
+
+		host, err := webhookTLSHost(params.URL)
+		if err != nil {
+			return err
+		}
+
+		if (config.CertFile == "") != (config.KeyFile == "") {
+			return errors.New("webhook certificate and key files must be set together")
+		}
+
+		if config.Validity == 0 {
+			config.Validity = defaultWebhookCertValidity
+		}
+		if config.RenewBefore == 0 {
+			config.RenewBefore = min(defaultWebhookCertRenewBefore, config.Validity/2)
+		}
+		if config.Validity < 0 || config.RenewBefore < 0 || config.RenewBefore >= config.Validity {
+			return fmt.Errorf("invalid webhook certificate validity %s and renew before %s",
+				config.Validity, config.RenewBefore)
+		}
+
+		webhookCtx.tls = &webhookTLS{
+			ctx:    ctx,
+			params: *params,
+			config: config,
+			host:   host,
+		}
+		return nil
+	}
+}
+
+// webhookTLSHost returns host of webhook URL, validating that it can be used with self-signed certificate
+func webhookTLSHost(webhookURL string) (string, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return "", fmt.Errorf("parse webhook URL: %w", err)
+	}
+
+	if u.Scheme != "https" {
+		return "", fmt.Errorf("webhook URL scheme must be https, got %q", u.Scheme)
+	}
+
+	if u.Hostname() == "" {
+		return "", errors.New("webhook URL host is empty")
+	}
+
+	port := 443
+	if u.Port() != "" {
+		port, err = strconv.Atoi(u.Port())
+		if err != nil {
+			return "", fmt.Errorf("parse webhook URL port: %w", err)
+		}
+	}
+
+	if !slices.Contains(WebhookPorts, port) {
+		return "", fmt.Errorf("webhook URL port must be one of %v, got %d", WebhookPorts, port)
+	}
+
+	return u.Hostname(), nil
+}
+
+// tlsWebhookServer represents webhook server that can serve HTTPS
+type tlsWebhookServer interface {
+	withTLSConfig(config *tls.Config) (WebhookServer, error)
+}
+
+// webhookServerWithTLSConfig returns server configured to serve HTTPS using provided TLS config
+func webhookServerWithTLSConfig(server WebhookServer, config *tls.Config) (WebhookServer, error) {
+	tlsServer, ok := server.(tlsWebhookServer)
+	if !ok {
+		return nil, fmt.Errorf("webhook server %T does not support TLS", server)
+	}
+	return tlsServer.withTLSConfig(config)
+}
+
+// webhookTLS represents self-signed certificate used by webhook
+type webhookTLS struct {
+	ctx    context.Context
+	params SetWebhookParams
+	config WebhookTLSConfig
+	host   string
+
+	lock sync.RWMutex
+	cert *tls.Certificate
+}
+
+// setup loads or generates certificate, configures server to serve HTTPS and sets webhook
+func (t *webhookTLS) setup(bot *Bot, server WebhookServer) (WebhookServer, error) {
+	cert, certPEM, err := t.load()
+	if err != nil {
+		return nil, err
+	}
+
+	if cert == nil || t.needsRenewal(cert) {
+		var keyPEM []byte
+		cert, certPEM, keyPEM, err = t.generate()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = t.save(certPEM, keyPEM); err != nil {
+			return nil, err
+		}
+	}
+
+	server, err = webhookServerWithTLSConfig(server, &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: t.getCertificate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	t.swapCertificate(cert)
+	if err = t.setWebhook(t.ctx, bot, certPEM, t.params.DropPendingUpdates); err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+// load loads certificate from files if they exist, returns nil certificate otherwise
+func (t *webhookTLS) load() (*tls.Certificate, []byte, error) {
+	if t.config.CertFile == "" {
+		return nil, nil, nil
+	}
+
+	certPEM, err := os.ReadFile(t.config.CertFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("read certificate: %w", err)
+	}
+
+	keyPEM, err := os.ReadFile(t.config.KeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read certificate key: %w", err)
+	}
+
+	cert, err := parseWebhookCertificate(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, certPEM, nil
+}
+
+// save atomically saves certificate and key to files if they are set, certificate is saved first
+func (t *webhookTLS) save(certPEM, keyPEM []byte) error {
+	if t.config.CertFile == "" {
+		return nil
+	}
+
+	if err := atomicfile.WriteFile(t.config.CertFile, certPEM); err != nil {
+		return fmt.Errorf("write certificate: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(t.config.KeyFile, keyPEM); err != nil {
+		return fmt.Errorf("write certificate key: %w", err)
+	}
+
+	return nil
+}
+
+// generate generates new self-signed certificate for webhook host
+func (t *webhookTLS) generate() (*tls.Certificate, []byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, webhookCertKeyBits)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("generate certificate key: %w", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("generate certificate serial number: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: t.host},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(t.config.Validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	if ip := net.ParseIP(t.host); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{t.host}
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("marshal certificate key: %w", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	cert, err := parseWebhookCertificate(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return cert, certPEM, keyPEM, nil
+}
+
+// parseWebhookCertificate parses PEM encoded certificate and key, leaf certificate is always parsed
+func parseWebhookCertificate(certPEM, keyPEM []byte) (*tls.Certificate, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parse certificate: %w", err)
+	}
+
+	if cert.Leaf == nil {
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate leaf: %w", err)
+		}
+	}
+
+	return &cert, nil
+}
+
+// setWebhook calls [Bot.SetWebhook] method with certificate
+func (t *webhookTLS) setWebhook(ctx context.Context, bot *Bot, certPEM []byte, dropPendingUpdates bool) error {
+	params := t.params
+	params.Certificate = &InputFile{File: &namedBytesReader{
+		Reader: bytes.NewReader(certPEM),
+		name:   webhookCertFileName,
+	}}
+	params.DropPendingUpdates = dropPendingUpdates
+
+	return bot.SetWebhook(ctx, &params)
+}
+
+// renew generates new certificate, saves it, starts serving it and sets webhook with it. If certificate can't be
+// saved or webhook can't be set, previous certificate is kept being served.
+func (t *webhookTLS) renew(ctx context.Context, bot *Bot) error {
+	cert, certPEM, keyPEM, err := t.generate()
+	if err != nil {
+		return err
+	}
+
+	if err = t.save(certPEM, keyPEM); err != nil {
+		return err
+	}
+
+	previous := t.swapCertificate(cert)
+	if err = t.setWebhook(ctx, bot, certPEM, false); err != nil {
+		t.swapCertificate(previous)
+		return err
+	}
+
+	return nil
+}
+
+// renewIn returns duration after which current certificate should be renewed
+func (t *webhookTLS) renewIn() time.Duration {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return max(time.Until(t.cert.Leaf.NotAfter.Add(-t.config.RenewBefore)), 0)
+}
+
+// needsRenewal reports whether certificate expires within renew period
+func (t *webhookTLS) needsRenewal(cert *tls.Certificate) bool {
+	return time.Until(cert.Leaf.NotAfter) <= t.config.RenewBefore
+}
+
+// swapCertificate sets served certificate and returns previous one
+func (t *webhookTLS) swapCertificate(cert *tls.Certificate) *tls.Certificate {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	previous := t.cert
+	t.cert = cert
+	return previous
+}
+
+// getCertificate returns served certificate, used as [tls.Config.GetCertificate]
+func (t *webhookTLS) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.cert, nil
+}
+
+// renewWebhookCertificate renews webhook certificate before expiry until webhook is stopped
+func (b *Bot) renewWebhookCertificate(webhookCtx *webhookContext) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-webhookCtx.stop
+		cancel()
+	}()
+
+	wait := webhookCtx.tls.renewIn()
+	for {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := webhookCtx.tls.renew(ctx, b); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			b.logAttrs(ctx, slog.LevelError, "Webhook certificate renewal error", slog.Any(LogKeyError, err))
+			wait = webhookCertRetryInterval
+			continue
+		}
+
+		b.logAttrs(ctx, slog.LevelInfo, "Webhook certificate renewed")
+		wait = webhookCtx.tls.renewIn()
+	}
+}
+
+// namedBytesReader represents in-memory implementation of [telegoapi.NamedReader]
+type namedBytesReader struct {
+	*bytes.Reader
+	name string
+}
+
+// Name returns name of reader
+func (r *namedBytesReader) Name() string {
+	return r.name
+}
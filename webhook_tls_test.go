@@ -0,0 +1,327 @@
+package telego
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+func TestWithWebhookSelfSignedTLS(t *testing.T) {
+	params := &SetWebhookParams{URL: "https://example.com:8443/bot"}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := &webhookContext{}
+		err := WithWebhookSelfSignedTLS(context.Background(), params, WebhookTLSConfig{})(nil, ctx)
+		require.NoError(t, err)
+
+		require.NotNil(t, ctx.tls)
+		assert.Equal(t, "example.com", ctx.tls.host)
+		assert.Equal(t, defaultWebhookCertValidity, ctx.tls.config.Validity)
+		assert.Equal(t, defaultWebhookCertRenewBefore, ctx.tls.config.RenewBefore)
+	})
+
+	t.Run("success_short_validity", func(t *testing.T) {
+		ctx := &webhookContext{}
+		err := WithWebhookSelfSignedTLS(context.Background(), &SetWebhookParams{URL: "https://127.0.0.1/bot"},
+			WebhookTLSConfig{Validity: time.Hour * 24})(nil, ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, "127.0.0.1", ctx.tls.host)
+		assert.Equal(t, time.Hour*12, ctx.tls.config.RenewBefore)
+	})
+
+	tests := []struct {
+		name   string
+		params *SetWebhookParams
+		config WebhookTLSConfig
+	}{
+		{
+			name: "error_nil_params",
+		},
+		{
+			name:   "error_invalid_url",
+			params: &SetWebhookParams{URL: "https://example.com:port"},
+		},
+		{
+			name:   "error_not_https",
+			params: &SetWebhookParams{URL: "http://example.com/bot"},
+		},
+		{
+			name:   "error_no_host",
+			params: &SetWebhookParams{URL: "https:///bot"},
+		},
+		{
+			name:   "error_port",
+			params: &SetWebhookParams{URL: "https://example.com:8080/bot"},
+		},
+		{
+			name:   "error_files",
+			params: params,
+			config: WebhookTLSConfig{CertFile: "cert.pem"},
+		},
+		{
+			name:   "error_renew_before",
+			params: params,
+			config: WebhookTLSConfig{Validity: time.Hour, RenewBefore: time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithWebhookSelfSignedTLS(context.Background(), tt.params, tt.config)(nil, &webhookContext{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestBot_UpdatesViaWebhook_SelfSignedTLS(t *testing.T) {
+	servers := map[string]func() WebhookServer{
+		"fasthttp": func() WebhookServer {
+			return FastHTTPWebhookServer{
+				Server: &fasthttp.Server{},
+				Router: router.New(),
+			}
+		},
+		"http": func() WebhookServer {
+			return &MultiBotWebhookServer{
+				Server: HTTPWebhookServer{
+					Server:   &http.Server{}, //nolint:gosec
+					ServeMux: http.NewServeMux(),
+				},
+			}
+		},
+	}
+
+	for name, server := range servers {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := newMockedBot(ctrl)
+
+			var lock sync.Mutex
+			var certificates [][]byte
+			var dropPendingUpdates []string
+
+			m.MockRequestConstructor.EXPECT().
+				MultipartRequest(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(parameters map[string]string, files map[string]ta.NamedReader) (*ta.RequestData, error) {
+					certificate, err := io.ReadAll(files["certificate"])
+					assert.NoError(t, err)
+
+					lock.Lock()
+					certificates = append(certificates, certificate)
+					dropPendingUpdates = append(dropPendingUpdates, parameters["drop_pending_updates"])
+					lock.Unlock()
+
+					return &ta.RequestData{Buffer: bytes.NewBuffer(nil)}, nil
+				}).MinTimes(2)
+
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&ta.Response{Ok: true}, nil).
+				MinTimes(2)
+
+			_, err := m.Bot.UpdatesViaWebhook("/bot", WithWebhookServer(server()),
+				WithWebhookSelfSignedTLS(context.Background(), &SetWebhookParams{
+					URL:                "https://127.0.0.1:8443/bot",
+					DropPendingUpdates: true,
+				}, WebhookTLSConfig{
+					Validity:    time.Second * 3,
+					RenewBefore: time.Second*2 + time.Millisecond*500,
+				}))
+			require.NoError(t, err)
+
+			addr := testAddress(t)
+			go func() {
+				startErr := m.Bot.StartWebhook(addr)
+				assert.NoError(t, startErr)
+			}()
+
+			uploaded := func() [][]byte {
+				lock.Lock()
+				defer lock.Unlock()
+				return slices.Clone(certificates)
+			}
+
+			servedCertificate := func() []byte {
+				pool := x509.NewCertPool()
+				for _, certificate := range uploaded() {
+					pool.AppendCertsFromPEM(certificate)
+				}
+
+				conn, dialErr := tls.Dial("tcp", addr, &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+				if dialErr != nil {
+					return nil
+				}
+				defer func() { _ = conn.Close() }()
+
+				return conn.ConnectionState().PeerCertificates[0].Raw
+			}
+
+			var firstServed []byte
+			require.Eventually(t, func() bool {
+				firstServed = servedCertificate()
+				return firstServed != nil
+			}, time.Second*2, time.Millisecond*10)
+
+			require.Eventually(t, func() bool {
+				return len(uploaded()) >= 2
+			}, time.Second*5, time.Millisecond*10)
+
+			certs := uploaded()
+			assert.NotEqual(t, certs[0], certs[1])
+
+			lock.Lock()
+			assert.Equal(t, []string{"true", ""}, dropPendingUpdates[:2])
+			lock.Unlock()
+
+			assert.Eventually(t, func() bool {
+				served := servedCertificate()
+				return served != nil && !bytes.Equal(firstServed, served)
+			}, time.Second*2, time.Millisecond*10)
+
+			require.NoError(t, m.Bot.StopWebhook())
+		})
+	}
+}
+
+func TestBot_UpdatesViaWebhook_SelfSignedTLSFiles(t *testing.T) {
+	dir := t.TempDir()
+	config := WebhookTLSConfig{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	}
+	params := &SetWebhookParams{URL: "https://example.com/bot"}
+
+	ctrl := gomock.NewController(t)
+	m := newMockedBot(ctrl)
+
+	var certificates [][]byte
+	m.MockRequestConstructor.EXPECT().
+		MultipartRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ map[string]string, files map[string]ta.NamedReader) (*ta.RequestData, error) {
+			certificate, err := io.ReadAll(files["certificate"])
+			assert.NoError(t, err)
+			certificates = append(certificates, certificate)
+
+			return &ta.RequestData{Buffer: bytes.NewBuffer(nil)}, nil
+		}).Times(2)
+
+	m.MockAPICaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&ta.Response{Ok: true}, nil).
+		Times(2)
+
+	for range 2 {
+		_, err := m.Bot.UpdatesViaWebhook("/bot", WithWebhookSelfSignedTLS(context.Background(), params, config))
+		require.NoError(t, err)
+		require.NoError(t, m.Bot.StopWebhook())
+	}
+
+	require.Len(t, certificates, 2)
+	assert.Equal(t, certificates[0], certificates[1])
+	assert.FileExists(t, config.KeyFile)
+
+	t.Run("error_unsupported_server", func(t *testing.T) {
+		_, err := m.Bot.UpdatesViaWebhook("/bot", WithWebhookServer(NoOpWebhookServer{}),
+			WithWebhookSelfSignedTLS(context.Background(), params, config))
+		require.Error(t, err)
+	})
+
+	t.Run("error_set_webhook", func(t *testing.T) {
+		m.MockRequestConstructor.EXPECT().
+			MultipartRequest(gomock.Any(), gomock.Any()).
+			Return(nil, errTest)
+
+		_, err := m.Bot.UpdatesViaWebhook("/bot", WithWebhookSelfSignedTLS(context.Background(), params, config))
+		require.Error(t, err)
+	})
+}
+
+func TestWebhookTLS_renew(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := newMockedBot(ctrl)
+
+	// Certificate can't be saved until directory is created
+	dir := filepath.Join(t.TempDir(), "certs")
+	webhookCtx := &webhookContext{}
+	err := WithWebhookSelfSignedTLS(ctx, &SetWebhookParams{URL: "https://example.com/bot"}, WebhookTLSConfig{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	})(m.Bot, webhookCtx)
+	require.NoError(t, err)
+
+	webhookTLS := webhookCtx.tls
+	cert, _, _, err := webhookTLS.generate()
+	require.NoError(t, err)
+	webhookTLS.swapCertificate(cert)
+
+	served := func() *tls.Certificate {
+		servedCert, certErr := webhookTLS.getCertificate(nil)
+		require.NoError(t, certErr)
+		return servedCert
+	}
+
+	t.Run("error_save", func(t *testing.T) {
+		require.Error(t, webhookTLS.renew(ctx, m.Bot))
+		assert.Equal(t, cert, served())
+	})
+
+	require.NoError(t, os.Mkdir(dir, 0o750))
+
+	t.Run("success", func(t *testing.T) {
+		m.MockRequestConstructor.EXPECT().
+			MultipartRequest(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ map[string]string, files map[string]ta.NamedReader) (*ta.RequestData, error) {
+				certificate, readErr := io.ReadAll(files["certificate"])
+				require.NoError(t, readErr)
+
+				// New certificate is saved and served before webhook is set
+				savedCert, _, loadErr := webhookTLS.load()
+				require.NoError(t, loadErr)
+
+				block, _ := pem.Decode(certificate)
+				require.NotNil(t, block)
+				assert.Equal(t, block.Bytes, served().Leaf.Raw)
+				assert.Equal(t, block.Bytes, savedCert.Leaf.Raw)
+
+				return &ta.RequestData{Buffer: bytes.NewBuffer(nil)}, nil
+			})
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&ta.Response{Ok: true}, nil)
+
+		require.NoError(t, webhookTLS.renew(ctx, m.Bot))
+		assert.NotEqual(t, cert, served())
+	})
+
+	t.Run("error_set_webhook", func(t *testing.T) {
+		previous := served()
+
+		m.MockRequestConstructor.EXPECT().
+			MultipartRequest(gomock.Any(), gomock.Any()).
+			Return(nil, errTest)
+
+		require.Error(t, webhookTLS.renew(ctx, m.Bot))
+		assert.Equal(t, previous, served())
+	})
+}
@@ -0,0 +1,290 @@
+package telego
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWebhookWatchdogInterval = time.Minute
+	webhookWatchdogCallTimeout     = time.Second * 30
+)
+
+// WebhookWatchdogConfig represents configuration of webhook watchdog
+type WebhookWatchdogConfig struct {
+	// Interval - Interval between checks. Default is 1 minute.
+	Interval time.Duration
+
+	// MaxPendingUpdates - Maximal number of pending updates for webhook to be ready, zero means no limit
+	MaxPendingUpdates int
+
+	// MaxErrorAge - Webhook isn't ready if Telegram reported delivery or synchronization error within this period.
+	// Default is Interval.
+	MaxErrorAge time.Duration
+
+	// ReadinessPath - Optional path of readiness endpoint registered on webhook server, it responds to GET requests
+	// with 200 status code if webhook is ready and with 503 otherwise
+	ReadinessPath string
+
+	// OnCheck - Optional callback called after each check
+	OnCheck func(health WebhookHealth)
+}
+
+// WebhookHealth represents result of webhook watchdog check
+type WebhookHealth struct {
+	// Info - Webhook info returned by Telegram
+	Info WebhookInfo
+
+	// CheckedAt - Time of the check
+	CheckedAt time.Time
+
+	// Drift - Differences between webhook info and configured webhook, empty if there is no drift
+	Drift []string
+
+	// Reregistered - True, if webhook was set again because of drift
+	Reregistered bool
+
+	// Err - Error of getting webhook info or setting webhook
+	Err error
+
+	// Ready - True, if webhook is registered as configured and Telegram delivers updates without errors
+	Ready bool
+
+	// Reason - Reason why webhook isn't ready, empty if it's ready
+	Reason string
+}
+
+// WithWebhookWatchdog periodically checks webhook info while webhook is running, reports it using callback,
+// [Bot.WebhookHealth] method and readiness endpoint, and calls [Bot.SetWebhook] method with provided params if
+// registration drifted from them (for example, if another deployment set a different URL).
+// If webhook uses self-signed certificate (see [WithWebhookSelfSignedTLS]), it's uploaded on re-registration.
+//
+// Note: Telegram doesn't return secret token in webhook info, so its drift is detected by webhook errors with 401
+// status code (returned by webhook servers on secret token mismatch) reported after the last check.
+func WithWebhookWatchdog(params *SetWebhookParams, config WebhookWatchdogConfig) WebhookOption {
+	return func(_ *Bot, ctx *webhookContext) error {
+		if params == nil {
+			return errors.New("webhook params are nil")
+		}
+
+		if config.Interval == 0 {
+			config.Interval = defaultWebhookWatchdogInterval
+		}
+		if config.MaxErrorAge == 0 {
+			config.MaxErrorAge = config.Interval
+		}
+		if config.Interval < 0 || config.MaxErrorAge < 0 || config.MaxPendingUpdates < 0 {
+			return errors.New("webhook watchdog interval, max error age and max pending updates should not be negative")
+		}
+
+		ctx.watchdog = &webhookWatchdog{
+			params: *params,
+			config: config,
+		}
+		return nil
+	}
+}
+
+// WebhookHealth returns result of the last webhook watchdog check, false if watchdog isn't configured
+// (see [WithWebhookWatchdog]). Health without check time is returned if no checks were done yet.
+func (b *Bot) WebhookHealth() (WebhookHealth, bool) {
+	ctx := b.webhookContext
+	if ctx == nil || ctx.watchdog == nil {
+		return WebhookHealth{}, false
+	}
+	return ctx.watchdog.health(), true
+}
+
+// webhookWatchdog represents state of webhook watchdog
+type webhookWatchdog struct {
+	params SetWebhookParams
+	config WebhookWatchdogConfig
+
+	lock       sync.RWMutex
+	lastHealth WebhookHealth
+}
+
+// health returns result of the last check
+func (w *webhookWatchdog) health() WebhookHealth {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.lastHealth
+}
+
+// ready reports whether webhook is ready and reason if it's not, used by readiness endpoint
+func (w *webhookWatchdog) ready() (bool, string) {
+	health := w.health()
+	if health.CheckedAt.IsZero() {
+		return false, "not checked yet"
+	}
+	return health.Ready, health.Reason
+}
+
+// drift returns differences between webhook info and configured webhook
+func (w *webhookWatchdog) drift(info *WebhookInfo, lastCheck time.Time) []string {
+	var drift []string
+
+	if info.URL != w.params.URL {
+		drift = append(drift, fmt.Sprintf("URL is %q, expected %q", info.URL, w.params.URL))
+	}
+
+	if len(w.params.AllowedUpdates) > 0 {
+		actual := slices.Clone(info.AllowedUpdates)
+		expected := slices.Clone(w.params.AllowedUpdates)
+		slices.Sort(actual)
+		slices.Sort(expected)
+		if !slices.Equal(slices.Compact(actual), slices.Compact(expected)) {
+			drift = append(drift, fmt.Sprintf("allowed updates are %v, expected %v",
+				info.AllowedUpdates, w.params.AllowedUpdates))
+		}
+	}
+
+	if w.params.MaxConnections != 0 && info.MaxConnections != w.params.MaxConnections {
+		drift = append(drift, fmt.Sprintf("max connections is %d, expected %d",
+			info.MaxConnections, w.params.MaxConnections))
+	}
+
+	if w.params.IPAddress != "" && info.IPAddress != w.params.IPAddress {
+		drift = append(drift, fmt.Sprintf("IP address is %q, expected %q", info.IPAddress, w.params.IPAddress))
+	}
+
+	if w.params.SecretToken != "" && info.LastErrorDate != 0 && !lastCheck.IsZero() &&
+		time.Unix(info.LastErrorDate, 0).After(lastCheck.Add(-time.Second)) &&
+		strings.Contains(info.LastErrorMessage, strconv.Itoa(http.StatusUnauthorized)) {
+		drift = append(drift, fmt.Sprintf("secret token is rejected: %s", info.LastErrorMessage))
+	}
+
+	return drift
+}
+
+// readiness returns reason why webhook isn't ready based on webhook info, empty if it's ready
+func (w *webhookWatchdog) readiness(info *WebhookInfo, now time.Time) string {
+	if w.config.MaxPendingUpdates > 0 && info.PendingUpdateCount > w.config.MaxPendingUpdates {
+		return fmt.Sprintf("%d pending updates, max %d", info.PendingUpdateCount, w.config.MaxPendingUpdates)
+	}
+
+	errorsSince := now.Add(-w.config.MaxErrorAge)
+	if info.LastErrorDate != 0 && time.Unix(info.LastErrorDate, 0).After(errorsSince) {
+		return fmt.Sprintf("last error: %s", info.LastErrorMessage)
+	}
+	if info.LastSynchronizationErrorDate != 0 && time.Unix(info.LastSynchronizationErrorDate, 0).After(errorsSince) {
+		return "recent synchronization error"
+	}
+
+	return ""
+}
+
+// checkWebhook checks webhook info, sets webhook again if it drifted and returns its health
+func (b *Bot) checkWebhook(ctx context.Context, webhookCtx *webhookContext) WebhookHealth {
+	w := webhookCtx.watchdog
+	lastCheck := w.health().CheckedAt
+	health := WebhookHealth{CheckedAt: time.Now()}
+
+	callCtx, cancel := context.WithTimeout(ctx, webhookWatchdogCallTimeout)
+	defer cancel()
+
+	info, err := b.GetWebhookInfo(callCtx)
+	if err != nil {
+		health.Err = err
+		health.Reason = fmt.Sprintf("get webhook info: %s", err)
+		return health
+	}
+	health.Info = *info
+
+	health.Drift = w.drift(info, lastCheck)
+	if len(health.Drift) > 0 {
+		b.logAttrs(ctx, slog.LevelWarn, "Webhook registration drifted",
+			slog.String(LogKeyURL, info.URL), slog.Any("drift", health.Drift))
+
+		if err = b.reregisterWebhook(callCtx, webhookCtx, w.params); err != nil {
+			health.Err = err
+			health.Reason = fmt.Sprintf("webhook drifted: %s, set webhook: %s", strings.Join(health.Drift, ", "), err)
+			return health
+		}
+		health.Reregistered = true
+	}
+
+	health.Reason = w.readiness(info, health.CheckedAt)
+	health.Ready = health.Reason == ""
+	return health
+}
+
+// reregisterWebhook sets webhook with provided params keeping pending updates, uploading self-signed certificate
+// instead if it's used
+func (b *Bot) reregisterWebhook(ctx context.Context, webhookCtx *webhookContext, params SetWebhookParams) error {
+	if webhookCtx.tls != nil {
+		cert, _ := webhookCtx.tls.getCertificate(nil)
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Leaf.Raw})
+		return webhookCtx.tls.setWebhook(ctx, b, certPEM, false)
+	}
+
+	params.DropPendingUpdates = false
+	return b.SetWebhook(ctx, &params)
+}
+
+// runWebhookWatchdog checks webhook until webhook is stopped
+func (b *Bot) runWebhookWatchdog(webhookCtx *webhookContext) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-webhookCtx.stop
+		cancel()
+	}()
+
+	w := webhookCtx.watchdog
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		health := b.checkWebhook(ctx, webhookCtx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.lock.Lock()
+		w.lastHealth = health
+		w.lock.Unlock()
+
+		if w.config.OnCheck != nil {
+			w.config.OnCheck(health)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// readinessWebhookServer represents webhook server that can serve readiness endpoint
+type readinessWebhookServer interface {
+	registerReadinessHandler(path string, ready func() (bool, string)) error
+}
+
+// registerWebhookReadiness registers readiness endpoint on webhook server
+func registerWebhookReadiness(server WebhookServer, path string, ready func() (bool, string)) error {
+	readinessServer, ok := server.(readinessWebhookServer)
+	if !ok {
+		return fmt.Errorf("webhook server %T does not support readiness endpoint", server)
+	}
+	return readinessServer.registerReadinessHandler(path, ready)
+}
+
+// readinessResponse returns status code and body of readiness endpoint
+func readinessResponse(ready func() (bool, string)) (int, string) {
+	ok, reason := ready()
+	if !ok {
+		return http.StatusServiceUnavailable, "not ready: " + reason
+	}
+	return http.StatusOK, "ready"
+}
@@ -0,0 +1,400 @@
+package telego
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/mock/gomock"
+
+	ta "github.com/mymmrac/telego/telegoapi"
+)
+
+func TestWithWebhookWatchdog(t *testing.T) {
+	params := &SetWebhookParams{URL: "https://example.com/bot"}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := &webhookContext{}
+		err := WithWebhookWatchdog(params, WebhookWatchdogConfig{})(nil, ctx)
+		require.NoError(t, err)
+
+		require.NotNil(t, ctx.watchdog)
+		assert.Equal(t, *params, ctx.watchdog.params)
+		assert.Equal(t, defaultWebhookWatchdogInterval, ctx.watchdog.config.Interval)
+		assert.Equal(t, defaultWebhookWatchdogInterval, ctx.watchdog.config.MaxErrorAge)
+	})
+
+	tests := []struct {
+		name   string
+		params *SetWebhookParams
+		config WebhookWatchdogConfig
+	}{
+		{
+			name: "error_nil_params",
+		},
+		{
+			name:   "error_interval",
+			params: params,
+			config: WebhookWatchdogConfig{Interval: -time.Second},
+		},
+		{
+			name:   "error_max_pending_updates",
+			params: params,
+			config: WebhookWatchdogConfig{MaxPendingUpdates: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithWebhookWatchdog(tt.params, tt.config)(nil, &webhookContext{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestWebhookWatchdog_drift(t *testing.T) {
+	lastCheck := time.Now().Add(-time.Minute)
+
+	w := &webhookWatchdog{
+		params: SetWebhookParams{
+			URL:            "https://example.com/bot",
+			AllowedUpdates: []string{"message", "callback_query"},
+			MaxConnections: 10,
+			IPAddress:      "1.2.3.4",
+			SecretToken:    "secret",
+		},
+	}
+
+	info := &WebhookInfo{
+		URL:            "https://example.com/bot",
+		AllowedUpdates: []string{"callback_query", "message"},
+		MaxConnections: 10,
+		IPAddress:      "1.2.3.4",
+	}
+	assert.Empty(t, w.drift(info, lastCheck))
+
+	info.LastErrorDate = lastCheck.Add(-time.Minute).Unix()
+	info.LastErrorMessage = "Wrong response from the webhook: 401 Unauthorized"
+	assert.Empty(t, w.drift(info, lastCheck))
+	assert.Empty(t, w.drift(info, time.Time{}))
+
+	drifted := &WebhookInfo{
+		URL:              "https://other.example.com/bot",
+		AllowedUpdates:   []string{"message"},
+		MaxConnections:   40,
+		IPAddress:        "4.3.2.1",
+		LastErrorDate:    time.Now().Unix(),
+		LastErrorMessage: "Wrong response from the webhook: 401 Unauthorized",
+	}
+	assert.Len(t, w.drift(drifted, lastCheck), 5)
+
+	w.params = SetWebhookParams{URL: "https://other.example.com/bot"}
+	assert.Empty(t, w.drift(drifted, lastCheck))
+}
+
+func TestWebhookWatchdog_readiness(t *testing.T) {
+	now := time.Now()
+	w := &webhookWatchdog{
+		config: WebhookWatchdogConfig{
+			MaxPendingUpdates: 10,
+			MaxErrorAge:       time.Minute,
+		},
+	}
+
+	assert.Empty(t, w.readiness(&WebhookInfo{PendingUpdateCount: 10}, now))
+	assert.NotEmpty(t, w.readiness(&WebhookInfo{PendingUpdateCount: 11}, now))
+
+	assert.Empty(t, w.readiness(&WebhookInfo{LastErrorDate: now.Add(-time.Minute * 2).Unix()}, now))
+	assert.Equal(t, "last error: Connection refused", w.readiness(&WebhookInfo{
+		LastErrorDate:    now.Unix(),
+		LastErrorMessage: "Connection refused",
+	}, now))
+
+	assert.Empty(t, w.readiness(&WebhookInfo{LastSynchronizationErrorDate: now.Add(-time.Minute * 2).Unix()}, now))
+	assert.NotEmpty(t, w.readiness(&WebhookInfo{LastSynchronizationErrorDate: now.Unix()}, now))
+
+	ready, reason := w.ready()
+	assert.False(t, ready)
+	assert.Equal(t, "not checked yet", reason)
+
+	w.lastHealth = WebhookHealth{CheckedAt: now, Ready: true}
+	ready, reason = w.ready()
+	assert.True(t, ready)
+	assert.Empty(t, reason)
+}
+
+func TestBot_checkWebhook(t *testing.T) {
+	params := SetWebhookParams{
+		URL:         "https://example.com/bot",
+		SecretToken: "secret",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(&ta.RequestData{}, nil)
+
+		m.MockAPICaller.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(telegoResponse(t, WebhookInfo{URL: params.URL, PendingUpdateCount: 1}), nil)
+
+		webhookCtx := &webhookContext{watchdog: &webhookWatchdog{params: params}}
+		health := m.Bot.checkWebhook(context.Background(), webhookCtx)
+
+		assert.False(t, health.CheckedAt.IsZero())
+		assert.Equal(t, 1, health.Info.PendingUpdateCount)
+		assert.Empty(t, health.Drift)
+		assert.False(t, health.Reregistered)
+		assert.NoError(t, health.Err)
+		assert.True(t, health.Ready)
+		assert.Empty(t, health.Reason)
+	})
+
+	t.Run("success_reregistered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		var setParams *SetWebhookParams
+		gomock.InOrder(
+			m.MockRequestConstructor.EXPECT().
+				JSONRequest(gomock.Any()).
+				Return(&ta.RequestData{}, nil),
+			m.MockRequestConstructor.EXPECT().
+				JSONRequest(gomock.Any()).
+				DoAndReturn(func(parameters any) (*ta.RequestData, error) {
+					setParams, _ = parameters.(*SetWebhookParams)
+					return &ta.RequestData{}, nil
+				}),
+		)
+
+		gomock.InOrder(
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, WebhookInfo{URL: "https://other.example.com/bot"}), nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&ta.Response{Ok: true}, nil),
+		)
+
+		webhookCtx := &webhookContext{watchdog: &webhookWatchdog{params: params}}
+		health := m.Bot.checkWebhook(context.Background(), webhookCtx)
+
+		assert.Len(t, health.Drift, 1)
+		assert.True(t, health.Reregistered)
+		assert.NoError(t, health.Err)
+		assert.True(t, health.Ready)
+
+		require.NotNil(t, setParams)
+		assert.Equal(t, params, *setParams)
+	})
+
+	t.Run("error_get_webhook_info", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(nil, errTest)
+
+		webhookCtx := &webhookContext{watchdog: &webhookWatchdog{params: params}}
+		health := m.Bot.checkWebhook(context.Background(), webhookCtx)
+
+		assert.Error(t, health.Err)
+		assert.False(t, health.Ready)
+		assert.NotEmpty(t, health.Reason)
+	})
+
+	t.Run("error_set_webhook", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(&ta.RequestData{}, nil).
+			Times(2)
+
+		gomock.InOrder(
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, WebhookInfo{URL: "https://other.example.com/bot"}), nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, errTest),
+		)
+
+		webhookCtx := &webhookContext{watchdog: &webhookWatchdog{params: params}}
+		health := m.Bot.checkWebhook(context.Background(), webhookCtx)
+
+		assert.Len(t, health.Drift, 1)
+		assert.False(t, health.Reregistered)
+		assert.Error(t, health.Err)
+		assert.False(t, health.Ready)
+	})
+
+	t.Run("success_reregistered_tls", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := newMockedBot(ctrl)
+
+		tlsParams := &SetWebhookParams{URL: "https://example.com:8443/bot"}
+		webhookCtx := &webhookContext{}
+		require.NoError(t, WithWebhookSelfSignedTLS(context.Background(), tlsParams, WebhookTLSConfig{})(
+			m.Bot, webhookCtx))
+		require.NoError(t, WithWebhookWatchdog(tlsParams, WebhookWatchdogConfig{})(m.Bot, webhookCtx))
+
+		var certificates int
+		m.MockRequestConstructor.EXPECT().
+			MultipartRequest(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ map[string]string, files map[string]ta.NamedReader) (*ta.RequestData, error) {
+				if files["certificate"] != nil {
+					certificates++
+				}
+				return &ta.RequestData{Buffer: bytes.NewBuffer(nil)}, nil
+			}).
+			Times(2)
+
+		m.MockRequestConstructor.EXPECT().
+			JSONRequest(gomock.Any()).
+			Return(&ta.RequestData{}, nil)
+
+		gomock.InOrder(
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&ta.Response{Ok: true}, nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(telegoResponse(t, WebhookInfo{}), nil),
+			m.MockAPICaller.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&ta.Response{Ok: true}, nil),
+		)
+
+		_, err := webhookCtx.tls.setup(m.Bot, FastHTTPWebhookServer{
+			Server: &fasthttp.Server{},
+			Router: router.New(),
+		})
+		require.NoError(t, err)
+
+		health := m.Bot.checkWebhook(context.Background(), webhookCtx)
+		assert.True(t, health.Reregistered)
+		assert.NoError(t, health.Err)
+		assert.Equal(t, 2, certificates)
+	})
+}
+
+func TestBot_UpdatesViaWebhook_Watchdog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := newMockedBot(ctrl)
+
+	m.MockRequestConstructor.EXPECT().
+		JSONRequest(gomock.Any()).
+		Return(&ta.RequestData{}, nil).
+		MinTimes(2)
+
+	m.MockAPICaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(telegoResponse(t, WebhookInfo{URL: "https://example.com/bot"}), nil).
+		MinTimes(2)
+
+	checks := make(chan WebhookHealth, 8)
+	srv := &fasthttp.Server{}
+	_, err := m.Bot.UpdatesViaWebhook("/bot", WithWebhookServer(FastHTTPWebhookServer{
+		Server: srv,
+		Router: router.New(),
+	}), WithWebhookWatchdog(&SetWebhookParams{URL: "https://example.com/bot"}, WebhookWatchdogConfig{
+		Interval:      time.Millisecond * 10,
+		ReadinessPath: "/ready",
+		OnCheck: func(health WebhookHealth) {
+			select {
+			case checks <- health:
+			default:
+			}
+		},
+	}))
+	require.NoError(t, err)
+
+	health, ok := m.Bot.WebhookHealth()
+	require.True(t, ok)
+	assert.True(t, health.CheckedAt.IsZero())
+
+	ready := func() (int, string) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/ready")
+		ctx.Request.Header.SetMethod(fasthttp.MethodGet)
+		srv.Handler(ctx)
+		return ctx.Response.StatusCode(), string(ctx.Response.Body())
+	}
+
+	statusCode, body := ready()
+	assert.Equal(t, fasthttp.StatusServiceUnavailable, statusCode)
+	assert.Equal(t, "not ready: not checked yet", body)
+
+	go func() {
+		startErr := m.Bot.StartWebhook(testAddress(t))
+		assert.NoError(t, startErr)
+	}()
+
+	for range 2 {
+		select {
+		case health = <-checks:
+			assert.True(t, health.Ready)
+		case <-time.After(time.Second):
+			t.Fatal("webhook was not checked")
+		}
+	}
+
+	statusCode, body = ready()
+	assert.Equal(t, fasthttp.StatusOK, statusCode)
+	assert.Equal(t, "ready", body)
+
+	require.NoError(t, m.Bot.StopWebhook())
+
+	_, ok = m.Bot.WebhookHealth()
+	assert.False(t, ok)
+
+	t.Run("error_unsupported_server", func(t *testing.T) {
+		_, err = m.Bot.UpdatesViaWebhook("/bot", WithWebhookServer(NoOpWebhookServer{
+			RegisterHandlerFunc: func(_ string, _ WebhookHandler) error { return nil },
+		}), WithWebhookWatchdog(&SetWebhookParams{}, WebhookWatchdogConfig{ReadinessPath: "/ready"}))
+		require.Error(t, err)
+	})
+}
+
+func TestHTTPWebhookServer_registerReadinessHandler(t *testing.T) {
+	isReady := false
+	s := &MultiBotWebhookServer{
+		Server: HTTPWebhookServer{
+			Server:   &http.Server{}, //nolint:gosec
+			ServeMux: http.NewServeMux(),
+		},
+	}
+	require.NoError(t, registerWebhookReadiness(s, "/ready", func() (bool, string) {
+		return isReady, "test"
+	}))
+
+	handler := s.Server.(HTTPWebhookServer).Server.Handler
+
+	rc := httptest.NewRecorder()
+	handler.ServeHTTP(rc, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rc.Code)
+	assert.Equal(t, "not ready: test", rc.Body.String())
+
+	isReady = true
+	rc = httptest.NewRecorder()
+	handler.ServeHTTP(rc, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusOK, rc.Code)
+	assert.Equal(t, "ready", rc.Body.String())
+
+	rc = httptest.NewRecorder()
+	handler.ServeHTTP(rc, httptest.NewRequest(http.MethodPost, "/ready", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rc.Code)
+}